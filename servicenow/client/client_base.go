@@ -20,6 +20,8 @@ type Client struct {
 type ServiceNowClient interface {
 	GetObject(string, string, Record) error
 	GetObjectByName(string, string, Record) error
+	GetObjectByQuery(string, string, Record) error
+	GetObjectsByQuery(string, string, interface{}) error
 	CreateObject(string, Record) error
 	UpdateObject(string, Record) error
 	DeleteObject(string, string) error
@@ -113,6 +115,35 @@ func (client *Client) GetObjectByName(endpoint string, name string, responseObje
 	return parseResponseToRecord(jsonResponse, responseObjectOut)
 }
 
+// GetObjectByQuery retrieves a single object matching an encoded query, such as "user_name=admin".
+func (client *Client) GetObjectByQuery(endpoint string, query string, responseObjectOut Record) error {
+	jsonResponse, err := client.requestJSON("GET", endpoint+"?JSONv2&sysparm_query="+url.QueryEscape(query), nil)
+	if err != nil {
+		return err
+	}
+	return parseResponseToRecord(jsonResponse, responseObjectOut)
+}
+
+// GetObjectsByQuery retrieves all the objects matching an encoded query. responseObjectsOut
+// parameter must be a pointer to a slice of records.
+func (client *Client) GetObjectsByQuery(endpoint string, query string, responseObjectsOut interface{}) error {
+	jsonResponse, err := client.requestJSON("GET", endpoint+"?JSONv2&sysparm_query="+url.QueryEscape(query), nil)
+	if err != nil {
+		return err
+	}
+
+	results := struct {
+		Records json.RawMessage `json:"records"`
+	}{}
+	if err := json.Unmarshal(jsonResponse, &results); err != nil {
+		return err
+	}
+	if results.Records == nil {
+		return nil
+	}
+	return json.Unmarshal(results.Records, responseObjectsOut)
+}
+
 // CreateObject creates a new object in ServiceNow, validates the response and fills the object
 // with properties received from the service.
 func (client *Client) CreateObject(endpoint string, objectToCreate Record) error {
@@ -0,0 +1,12 @@
+package client
+
+// EndpointBasicAuthProfile is the endpoint to manage basic authentication profile records.
+const EndpointBasicAuthProfile = "sys_auth_profile_basic.do"
+
+// BasicAuthProfile represents the json response for a Basic Auth Profile in ServiceNow.
+type BasicAuthProfile struct {
+	BaseResult
+	Name     string `json:"name"`
+	UserName string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
@@ -0,0 +1,15 @@
+package client
+
+// EndpointCatalogCategory is the endpoint to manage catalog category records.
+const EndpointCatalogCategory = "sc_category.do"
+
+// CatalogCategory represents the json response for a Service Catalog Category in ServiceNow.
+type CatalogCategory struct {
+	BaseResult
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	CatalogID   string `json:"sc_catalog"`
+	ParentID    string `json:"parent"`
+	Active      bool   `json:"active,string"`
+	Roles       string `json:"roles"`
+}
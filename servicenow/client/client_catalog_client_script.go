@@ -0,0 +1,19 @@
+package client
+
+// EndpointCatalogClientScript is the endpoint to manage catalog client script records.
+const EndpointCatalogClientScript = "catalog_script_client.do"
+
+// CatalogClientScript represents the json response for a Catalog Client Script in ServiceNow.
+type CatalogClientScript struct {
+	BaseResult
+	Name                 string `json:"name"`
+	CatalogItemID        string `json:"cat_item"`
+	Type                 string `json:"type"`
+	Variable             string `json:"cat_variable"`
+	UIType               string `json:"ui_type"`
+	Script               string `json:"script"`
+	Active               bool   `json:"active,string"`
+	AppliesCatalogItem   bool   `json:"applies_catalog,string"`
+	AppliesRequestedItem bool   `json:"applies_req_item,string"`
+	AppliesCatalogTask   bool   `json:"applies_sc_task,string"`
+}
@@ -0,0 +1,29 @@
+package client
+
+// EndpointCatalogItem is the endpoint to manage catalog item records.
+const EndpointCatalogItem = "sc_cat_item.do"
+
+// EndpointCatalogItemCategory is the endpoint to manage the additional categories of catalog items.
+const EndpointCatalogItemCategory = "sc_cat_item_category.do"
+
+// CatalogItem represents the json response for a Service Catalog Item in ServiceNow.
+type CatalogItem struct {
+	BaseResult
+	Name               string `json:"name"`
+	ShortDescription   string `json:"short_description"`
+	Description        string `json:"description"`
+	CatalogIDs         string `json:"sc_catalogs"`
+	CategoryID         string `json:"category"`
+	WorkflowID         string `json:"workflow"`
+	FlowID             string `json:"flow_designer_flow"`
+	FulfillmentGroupID string `json:"group"`
+	Active             bool   `json:"active,string"`
+	Roles              string `json:"roles"`
+}
+
+// CatalogItemCategory represents the json response for an additional category of a catalog item in ServiceNow.
+type CatalogItemCategory struct {
+	BaseResult
+	CatalogItemID string `json:"sc_cat_item"`
+	CategoryID    string `json:"sc_category"`
+}
@@ -0,0 +1,36 @@
+package client
+
+// EndpointCatalogUIPolicy is the endpoint to manage catalog UI policy records.
+const EndpointCatalogUIPolicy = "catalog_ui_policy.do"
+
+// EndpointCatalogUIPolicyAction is the endpoint to manage the actions of a catalog UI policy.
+const EndpointCatalogUIPolicyAction = "catalog_ui_policy_action.do"
+
+// CatalogUIPolicy represents the json response for a Catalog UI Policy in ServiceNow.
+type CatalogUIPolicy struct {
+	BaseResult
+	ShortDescription     string `json:"short_description"`
+	CatalogItemID        string `json:"catalog_item"`
+	Conditions           string `json:"catalog_conditions"`
+	Order                int    `json:"order,string"`
+	OnLoad               bool   `json:"on_load,string"`
+	ReverseIfFalse       bool   `json:"reverse_if_false,string"`
+	Active               bool   `json:"active,string"`
+	AppliesCatalogItem   bool   `json:"applies_catalog,string"`
+	AppliesRequestedItem bool   `json:"applies_req_item,string"`
+	AppliesCatalogTask   bool   `json:"applies_sc_task,string"`
+	RunScripts           bool   `json:"run_scripts,string"`
+	ScriptTrue           string `json:"script_true"`
+	ScriptFalse          string `json:"script_false"`
+}
+
+// CatalogUIPolicyAction represents the json response for an action of a Catalog UI Policy in ServiceNow.
+type CatalogUIPolicyAction struct {
+	BaseResult
+	UIPolicyID    string `json:"ui_policy"`
+	CatalogItemID string `json:"catalog_item"`
+	Variable      string `json:"catalog_variable"`
+	Visible       string `json:"visible"`
+	Mandatory     string `json:"mandatory"`
+	ReadOnly      string `json:"disabled"`
+}
@@ -0,0 +1,32 @@
+package client
+
+// EndpointCatalogVariable is the endpoint to manage catalog variable records.
+const EndpointCatalogVariable = "item_option_new.do"
+
+// EndpointCatalogVariableChoice is the endpoint to manage the choices of a catalog variable.
+const EndpointCatalogVariableChoice = "question_choice.do"
+
+// CatalogVariable represents the json response for a variable of a Service Catalog Item in ServiceNow.
+type CatalogVariable struct {
+	BaseResult
+	CatalogItemID  string `json:"cat_item"`
+	Name           string `json:"name"`
+	Question       string `json:"question_text"`
+	Type           string `json:"type"`
+	Order          int    `json:"order,string"`
+	Mandatory      bool   `json:"mandatory,string"`
+	ReferenceTable string `json:"reference"`
+	DefaultValue   string `json:"default_value"`
+	Active         bool   `json:"active,string"`
+	MapToField     bool   `json:"map_to_field,string"`
+	Field          string `json:"field"`
+}
+
+// CatalogVariableChoice represents the json response for an option of a choice catalog variable in ServiceNow.
+type CatalogVariableChoice struct {
+	BaseResult
+	VariableID string `json:"question"`
+	Value      string `json:"value"`
+	Text       string `json:"text"`
+	Order      int    `json:"order,string"`
+}
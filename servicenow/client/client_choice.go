@@ -0,0 +1,17 @@
+package client
+
+// EndpointChoice is the endpoint to manage choice list records.
+const EndpointChoice = "sys_choice.do"
+
+// Choice is the json response for an option of a choice list in ServiceNow.
+type Choice struct {
+	BaseResult
+	TableName      string `json:"name"`
+	Element        string `json:"element"`
+	Value          string `json:"value"`
+	Label          string `json:"label"`
+	Sequence       int    `json:"sequence,string"`
+	Language       string `json:"language"`
+	Inactive       bool   `json:"inactive,string"`
+	DependentValue string `json:"dependent_value"`
+}
@@ -0,0 +1,31 @@
+package client
+
+// EndpointDataSource is the endpoint to manage import data source records.
+const EndpointDataSource = "sys_data_source.do"
+
+// DataSource represents the json response for an import Data Source in ServiceNow.
+type DataSource struct {
+	BaseResult
+	Name                string `json:"name"`
+	Type                string `json:"type"`
+	Format              string `json:"format"`
+	ImportSetTableName  string `json:"import_set_table_name"`
+	ImportSetTableLabel string `json:"import_set_table_label"`
+	FileRetrievalMethod string `json:"file_retrieval_method"`
+	FilePath            string `json:"file_path"`
+	HeaderRow           string `json:"header_row"`
+	XPathRootNode       string `json:"xpath_root_node"`
+	Server              string `json:"server"`
+	Port                string `json:"port"`
+	UserName            string `json:"username"`
+	Password            string `json:"password,omitempty"`
+	JDBCServer          string `json:"jdbc_server"`
+	JDBCPort            string `json:"database_port"`
+	JDBCUserName        string `json:"jdbc_user_name"`
+	JDBCPassword        string `json:"jdbc_password,omitempty"`
+	DatabaseName        string `json:"database_name"`
+	TableName           string `json:"table_name"`
+	SQLStatement        string `json:"sql_statement"`
+	LDAPTargetID        string `json:"ldap_target"`
+	MidServerID         string `json:"mid_server"`
+}
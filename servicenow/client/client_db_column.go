@@ -0,0 +1,23 @@
+package client
+
+// EndpointDBColumn is the endpoint to manage dictionary entry records.
+const EndpointDBColumn = "sys_dictionary.do"
+
+// DBColumn is the json response for a column (dictionary entry) of a Table in ServiceNow.
+type DBColumn struct {
+	BaseResult
+	TableName      string `json:"name"`
+	Element        string `json:"element"`
+	Label          string `json:"column_label"`
+	Type           string `json:"internal_type"`
+	MaxLength      int    `json:"max_length,string"`
+	ReferenceTable string `json:"reference"`
+	DefaultValue   string `json:"default_value"`
+	Mandatory      bool   `json:"mandatory,string"`
+	ReadOnly       bool   `json:"read_only,string"`
+	Unique         bool   `json:"unique,string"`
+	Display        bool   `json:"display,string"`
+	Attributes     string `json:"attributes"`
+	DependentField string `json:"dependent"`
+	Active         bool   `json:"active,string"`
+}
@@ -21,4 +21,12 @@ type DBTable struct {
 	CreateModule         bool   `json:"create_module,string"`
 	CreateMobileModule   bool   `json:"create_mobile_module,string"`
 	Name                 string `json:"name,omitempty"`
+	SuperClass           string `json:"super_class,omitempty"`
+	NumberRef            string `json:"number_ref,omitempty"`
+}
+
+// DBTableNumberRef is the json payload to set or clear the auto-number reference of a Table in ServiceNow.
+type DBTableNumberRef struct {
+	BaseResult
+	NumberRef string `json:"number_ref"`
 }
@@ -0,0 +1,26 @@
+package client
+
+// EndpointDBView is the endpoint to manage database view records.
+const EndpointDBView = "sys_db_view.do"
+
+// EndpointDBViewTable is the endpoint to manage the tables joined in database views.
+const EndpointDBViewTable = "sys_db_view_table.do"
+
+// DBView is the json response for a database view in ServiceNow.
+type DBView struct {
+	BaseResult
+	Name        string `json:"name"`
+	Label       string `json:"label"`
+	Description string `json:"description"`
+}
+
+// DBViewTable is the json response for a table joined in a database view in ServiceNow.
+type DBViewTable struct {
+	BaseResult
+	ViewID         string `json:"view"`
+	TableName      string `json:"table"`
+	VariablePrefix string `json:"variable_prefix"`
+	Order          int    `json:"order,string"`
+	WhereClause    string `json:"where_clause"`
+	LeftJoin       bool   `json:"left_join,string"`
+}
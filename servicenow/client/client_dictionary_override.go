@@ -0,0 +1,24 @@
+package client
+
+// EndpointDictionaryOverride is the endpoint to manage dictionary override records.
+const EndpointDictionaryOverride = "sys_dictionary_override.do"
+
+// DictionaryOverride is the json response for a dictionary override in ServiceNow.
+type DictionaryOverride struct {
+	BaseResult
+	TableName                  string `json:"name"`
+	BaseTable                  string `json:"base_table,omitempty"`
+	Element                    string `json:"element"`
+	DefaultValueOverride       bool   `json:"default_value_override,string"`
+	DefaultValue               string `json:"default_value"`
+	MandatoryOverride          bool   `json:"mandatory_override,string"`
+	Mandatory                  bool   `json:"mandatory,string"`
+	ReadOnlyOverride           bool   `json:"read_only_override,string"`
+	ReadOnly                   bool   `json:"read_only,string"`
+	ReferenceQualifierOverride bool   `json:"reference_qual_override,string"`
+	ReferenceQualifier         string `json:"reference_qual"`
+	CalculationOverride        bool   `json:"calculation_override,string"`
+	Calculation                string `json:"calculation"`
+	AttributesOverride         bool   `json:"attributes_override,string"`
+	Attributes                 string `json:"attributes"`
+}
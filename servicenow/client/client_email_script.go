@@ -0,0 +1,12 @@
+package client
+
+// EndpointEmailScript is the endpoint to manage notification email script records.
+const EndpointEmailScript = "sys_script_email.do"
+
+// EmailScript is the json response for a notification email script in ServiceNow.
+type EmailScript struct {
+	BaseResult
+	Name           string `json:"name"`
+	Script         string `json:"script"`
+	NewLinesToHTML bool   `json:"new_lines_to_html,string"`
+}
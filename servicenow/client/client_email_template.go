@@ -0,0 +1,13 @@
+package client
+
+// EndpointEmailTemplate is the endpoint to manage email template records.
+const EndpointEmailTemplate = "sysevent_email_template.do"
+
+// EmailTemplate is the json response for an email template in ServiceNow.
+type EmailTemplate struct {
+	BaseResult
+	Name        string `json:"name"`
+	Table       string `json:"collection"`
+	Subject     string `json:"subject"`
+	MessageHTML string `json:"message_html"`
+}
@@ -0,0 +1,14 @@
+package client
+
+// EndpointEvent is the endpoint to manage event registration records.
+const EndpointEvent = "sysevent_register.do"
+
+// Event is the json response for an event registration in ServiceNow.
+type Event struct {
+	BaseResult
+	Name        string `json:"event_name"`
+	Table       string `json:"table"`
+	FiredBy     string `json:"fired_by"`
+	Description string `json:"description"`
+	Queue       string `json:"queue"`
+}
@@ -0,0 +1,17 @@
+package client
+
+// EndpointFieldMap is the endpoint to manage field map records of a transform map.
+const EndpointFieldMap = "sys_transform_entry.do"
+
+// FieldMap represents the json response for a Field Map of a Transform Map in ServiceNow.
+type FieldMap struct {
+	BaseResult
+	TransformMapID  string `json:"map"`
+	SourceField     string `json:"source_field"`
+	TargetField     string `json:"target_field"`
+	Coalesce        bool   `json:"coalesce,string"`
+	ChoiceAction    string `json:"choice_action"`
+	DateFormat      string `json:"date_format"`
+	UseSourceScript bool   `json:"use_source_script,string"`
+	SourceScript    string `json:"source_script"`
+}
@@ -0,0 +1,15 @@
+package client
+
+// EndpointGroup is the endpoint to manage user group records.
+const EndpointGroup = "sys_user_group.do"
+
+// Group is the json response for a user group in ServiceNow.
+type Group struct {
+	BaseResult
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Email       string `json:"email"`
+	ManagerID   string `json:"manager"`
+	ParentID    string `json:"parent"`
+	Active      bool   `json:"active,string"`
+}
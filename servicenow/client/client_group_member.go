@@ -0,0 +1,11 @@
+package client
+
+// EndpointGroupMember is the endpoint to manage group membership records.
+const EndpointGroupMember = "sys_user_grmember.do"
+
+// GroupMember represents the json response for a group membership in ServiceNow.
+type GroupMember struct {
+	BaseResult
+	UserID  string `json:"user"`
+	GroupID string `json:"group"`
+}
@@ -0,0 +1,12 @@
+package client
+
+// EndpointGroupRole is the endpoint to manage role grants on groups.
+const EndpointGroupRole = "sys_group_has_role.do"
+
+// GroupRole represents the json response for a role granted to a group in ServiceNow.
+type GroupRole struct {
+	BaseResult
+	GroupID  string `json:"group"`
+	RoleID   string `json:"role"`
+	Inherits bool   `json:"inherits,string"`
+}
@@ -0,0 +1,20 @@
+package client
+
+// EndpointInboundEmailAction is the endpoint to manage inbound email action records.
+const EndpointInboundEmailAction = "sysevent_in_email_action.do"
+
+// InboundEmailAction is the json response for an inbound email action in ServiceNow.
+type InboundEmailAction struct {
+	BaseResult
+	Name           string `json:"name"`
+	Table          string `json:"collection"`
+	Action         string `json:"action"`
+	Type           string `json:"type"`
+	Condition      string `json:"filter_condition"`
+	StopProcessing bool   `json:"stop_processing,string"`
+	Order          int    `json:"order,string"`
+	RequiredRoles  string `json:"required_roles"`
+	Script         string `json:"script"`
+	ReplyEmail     string `json:"reply_email"`
+	Active         bool   `json:"active,string"`
+}
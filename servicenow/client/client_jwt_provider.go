@@ -0,0 +1,28 @@
+package client
+
+// EndpointJWTProvider is the endpoint to manage JWT provider records.
+const EndpointJWTProvider = "jwt_provider.do"
+
+// EndpointJWTKeystoreAlias is the endpoint to manage the signing keys used by JWT providers.
+const EndpointJWTKeystoreAlias = "jwt_keystore_aliases.do"
+
+// JWTProvider represents the json response for a JWT provider in ServiceNow.
+type JWTProvider struct {
+	BaseResult
+	Name            string `json:"name"`
+	ExpiryInterval  int    `json:"expiry_interval,string"`
+	KeystoreAliasID string `json:"signing_configuration"`
+	Active          bool   `json:"active,string"`
+}
+
+// JWTKeystoreAlias represents the json response for a JWT signing key in ServiceNow.
+type JWTKeystoreAlias struct {
+	BaseResult
+	Name               string `json:"name"`
+	KeystoreID         string `json:"keystore"`
+	SigningAlgorithm   string `json:"signing_algorithm"`
+	SigningKeyAlias    string `json:"signing_key_alias"`
+	SigningKeyPassword string `json:"signing_key_password,omitempty"`
+	KeyID              string `json:"kid"`
+	Active             bool   `json:"active,string"`
+}
@@ -0,0 +1,25 @@
+package client
+
+// EndpointNotification is the endpoint to manage email notification records.
+const EndpointNotification = "sysevent_email_action.do"
+
+// Notification is the json response for an email notification in ServiceNow.
+type Notification struct {
+	BaseResult
+	Name            string `json:"name"`
+	Table           string `json:"collection"`
+	GenerationType  string `json:"generation_type"`
+	ActionInsert    bool   `json:"action_insert,string"`
+	ActionUpdate    bool   `json:"action_update,string"`
+	EventName       string `json:"event_name"`
+	Condition       string `json:"condition"`
+	RecipientUsers  string `json:"recipient_users"`
+	RecipientGroups string `json:"recipient_groups"`
+	RecipientFields string `json:"recipient_fields"`
+	Subject         string `json:"subject"`
+	MessageHTML     string `json:"message_html"`
+	ContentType     string `json:"content_type"`
+	TemplateID      string `json:"template"`
+	Weight          int    `json:"weight,string"`
+	Active          bool   `json:"active,string"`
+}
@@ -0,0 +1,13 @@
+package client
+
+// EndpointNumber is the endpoint to manage auto-number records.
+const EndpointNumber = "sys_number.do"
+
+// Number is the json response for the auto-number configuration of a Table in ServiceNow.
+type Number struct {
+	BaseResult
+	Category string `json:"category"`
+	Prefix   string `json:"prefix"`
+	Digits   int    `json:"maximum_digits,string"`
+	Number   int    `json:"number,string"`
+}
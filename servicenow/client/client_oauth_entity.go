@@ -9,6 +9,12 @@ type OAuthEntity struct {
 	Name                 string `json:"name"`
 	ClientUUID           string `json:"client_uuid,omitempty"`
 	ClientID             string `json:"client_id,omitempty"`
+	ClientSecret         string `json:"client_secret,omitempty"`
+	Type                 string `json:"type"`
+	DefaultGrantType     string `json:"default_grant_type"`
+	AuthURL              string `json:"auth_url"`
+	TokenURL             string `json:"token_url"`
+	RevokeTokenURL       string `json:"revoke_token_url"`
 	AccessTokenLifespan  int    `json:"access_token_lifespan,string"`
 	RefreshTokenLifespan int    `json:"refresh_token_lifespan,string"`
 	RedirectURL          string `json:"redirect_url"`
@@ -0,0 +1,13 @@
+package client
+
+// EndpointOAuthEntityProfile is the endpoint to manage oauth entity profile records.
+const EndpointOAuthEntityProfile = "oauth_entity_profile.do"
+
+// OAuthEntityProfile represents the json response for an OAuth Entity Profile in ServiceNow.
+type OAuthEntityProfile struct {
+	BaseResult
+	Name          string `json:"name"`
+	OAuthEntityID string `json:"oauth_entity"`
+	GrantType     string `json:"grant_type"`
+	Default       bool   `json:"default,string"`
+}
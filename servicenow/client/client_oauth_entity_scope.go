@@ -0,0 +1,12 @@
+package client
+
+// EndpointOAuthEntityScope is the endpoint to manage oauth entity scope records.
+const EndpointOAuthEntityScope = "oauth_entity_scope.do"
+
+// OAuthEntityScope represents the json response for a scope of an OAuth entity in ServiceNow.
+type OAuthEntityScope struct {
+	BaseResult
+	Name          string `json:"name"`
+	OAuthEntityID string `json:"oauth_entity"`
+	OAuthScope    string `json:"oauth_entity_scope"`
+}
@@ -0,0 +1,13 @@
+package client
+
+// EndpointProtocolProfile is the endpoint to manage protocol profile records.
+const EndpointProtocolProfile = "sys_protocol_profile.do"
+
+// ProtocolProfile represents the json response for a Protocol Profile used by mutual authentication in ServiceNow.
+type ProtocolProfile struct {
+	BaseResult
+	Name        string `json:"name"`
+	Protocol    string `json:"protocol"`
+	KeyStoreID  string `json:"key_store"`
+	DefaultPort int    `json:"default_port,string"`
+}
@@ -0,0 +1,19 @@
+package client
+
+// EndpointRecordProducer is the endpoint to manage record producer records.
+const EndpointRecordProducer = "sc_cat_item_producer.do"
+
+// RecordProducer represents the json response for a Record Producer in ServiceNow.
+type RecordProducer struct {
+	BaseResult
+	Name             string `json:"name"`
+	ShortDescription string `json:"short_description"`
+	Description      string `json:"description"`
+	CatalogIDs       string `json:"sc_catalogs"`
+	CategoryID       string `json:"category"`
+	TableName        string `json:"table_name"`
+	Template         string `json:"template"`
+	Script           string `json:"script"`
+	Active           bool   `json:"active,string"`
+	Roles            string `json:"roles"`
+}
@@ -0,0 +1,14 @@
+package client
+
+// EndpointRelationship is the endpoint to manage relationship records.
+const EndpointRelationship = "sys_relationship.do"
+
+// Relationship is the json response for a related list relationship in ServiceNow.
+type Relationship struct {
+	BaseResult
+	Name             string `json:"name"`
+	AppliesToTable   string `json:"basic_apply_to"`
+	QueriesFromTable string `json:"basic_query_from"`
+	QueryWith        string `json:"query_with"`
+	InsertCallback   string `json:"insert_callback"`
+}
@@ -0,0 +1,19 @@
+package client
+
+// EndpointRestAPIAccessPolicy is the endpoint to manage REST API access policy records.
+const EndpointRestAPIAccessPolicy = "sys_api_access_policy.do"
+
+// RestAPIAccessPolicy is the json response for a REST API access policy in ServiceNow.
+type RestAPIAccessPolicy struct {
+	BaseResult
+	Name                   string `json:"name"`
+	Active                 bool   `json:"active,string"`
+	RestAPIID              string `json:"rest_api"`
+	ApplyToAllVersions     bool   `json:"apply_to_all_versions,string"`
+	VersionID              string `json:"rest_api_version"`
+	ApplyToAllResources    bool   `json:"apply_to_all_resources,string"`
+	ResourceID             string `json:"rest_api_resource"`
+	ApplyToAllMethods      bool   `json:"apply_to_all_methods,string"`
+	HTTPMethod             string `json:"http_method"`
+	AuthenticationPolicyID string `json:"authentication_policy"`
+}
@@ -11,4 +11,11 @@ type RestMessage struct {
 	RestEndpoint       string `json:"rest_endpoint"`
 	Access             string `json:"access"`
 	AuthenticationType string `json:"authentication_type"`
+	BasicAuthProfileID string `json:"basic_auth_profile"`
+	OAuthProfileID     string `json:"oauth2_profile"`
+	UseMutualAuth      bool   `json:"use_mutual_auth,string"`
+	ProtocolProfileID  string `json:"protocol_profile"`
+	UseMidServer       bool   `json:"use_mid_server,string"`
+	MidServerID        string `json:"mid_server"`
+	MidServerClusterID string `json:"mid_server_cluster"`
 }
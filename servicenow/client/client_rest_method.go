@@ -11,5 +11,13 @@ type RestMethod struct {
 	HTTPMethod         string `json:"http_method"`
 	RestEndpoint       string `json:"rest_endpoint"`
 	AuthenticationType string `json:"authentication_type"`
+	BasicAuthProfileID string `json:"basic_auth_profile"`
+	OAuthProfileID     string `json:"oauth2_profile"`
+	UseMutualAuth      bool   `json:"use_mutual_auth,string"`
+	ProtocolProfileID  string `json:"protocol_profile"`
+	UseMidServer       bool   `json:"use_mid_server,string"`
+	MidServerID        string `json:"mid_server"`
+	MidServerClusterID string `json:"mid_server_cluster"`
+	Content            string `json:"content"`
 	QualifiedName      string `json:"qualified_name,omitempty"`
 }
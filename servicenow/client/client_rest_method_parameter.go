@@ -0,0 +1,12 @@
+package client
+
+// EndpointRestMethodParameter is the endpoint to manage REST Message method variable substitution records.
+const EndpointRestMethodParameter = "sys_rest_message_fn_param_defs.do"
+
+// RestMethodParameter represents the json response for a variable substitution of a HTTP method in ServiceNow.
+type RestMethodParameter struct {
+	BaseResult
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	MethodID string `json:"rest_message_function"`
+}
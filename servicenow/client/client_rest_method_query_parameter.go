@@ -0,0 +1,13 @@
+package client
+
+// EndpointRestMethodQueryParameter is the endpoint to manage REST Message method HTTP query parameter records.
+const EndpointRestMethodQueryParameter = "sys_rest_message_fn_parameters.do"
+
+// RestMethodQueryParameter represents the json response for a HTTP query parameter of a HTTP method in ServiceNow.
+type RestMethodQueryParameter struct {
+	BaseResult
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	MethodID string `json:"rest_message_function"`
+	Order    int    `json:"order,string"`
+}
@@ -0,0 +1,18 @@
+package client
+
+// EndpointRestRateLimit is the endpoint to manage REST API rate limit rules.
+const EndpointRestRateLimit = "sys_rate_limit_rules.do"
+
+// RestRateLimit is the json response for a REST API rate limit rule in ServiceNow.
+type RestRateLimit struct {
+	BaseResult
+	Name       string `json:"name"`
+	Active     bool   `json:"active,string"`
+	RestAPIID  string `json:"rest_api"`
+	ResourceID string `json:"rest_api_resource"`
+	HTTPMethod string `json:"http_method"`
+	AppliesTo  string `json:"type"`
+	UserID     string `json:"user"`
+	RoleID     string `json:"role"`
+	MaxPerHour int    `json:"rate_limit,string"`
+}
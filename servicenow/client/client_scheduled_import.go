@@ -0,0 +1,23 @@
+package client
+
+// EndpointScheduledImport is the endpoint to manage scheduled data import records.
+const EndpointScheduledImport = "scheduled_import_set.do"
+
+// ScheduledImport is the json response for a scheduled data import in ServiceNow.
+type ScheduledImport struct {
+	BaseResult
+	Name          string `json:"name"`
+	DataSourceID  string `json:"data_source"`
+	RunType       string `json:"run_type"`
+	RunTime       string `json:"run_time"`
+	RunDayOfWeek  string `json:"run_dayofweek"`
+	RunDayOfMonth string `json:"run_dayofmonth"`
+	RunPeriod     string `json:"run_period"`
+	RunStart      string `json:"run_start"`
+	Conditional   bool   `json:"conditional,string"`
+	Condition     string `json:"condition"`
+	RunAsID       string `json:"run_as"`
+	Active        bool   `json:"active,string"`
+	PreScript     string `json:"pre_script"`
+	PostScript    string `json:"post_script"`
+}
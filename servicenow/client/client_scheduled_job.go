@@ -0,0 +1,21 @@
+package client
+
+// EndpointScheduledJob is the endpoint to manage scheduled script job records.
+const EndpointScheduledJob = "sysauto_script.do"
+
+// ScheduledJob is the json response for a scheduled script job in ServiceNow.
+type ScheduledJob struct {
+	BaseResult
+	Name          string `json:"name"`
+	RunType       string `json:"run_type"`
+	RunTime       string `json:"run_time"`
+	RunDayOfWeek  string `json:"run_dayofweek"`
+	RunDayOfMonth string `json:"run_dayofmonth"`
+	RunPeriod     string `json:"run_period"`
+	RunStart      string `json:"run_start"`
+	Conditional   bool   `json:"conditional,string"`
+	Condition     string `json:"condition"`
+	RunAsID       string `json:"run_as"`
+	Active        bool   `json:"active,string"`
+	Script        string `json:"script"`
+}
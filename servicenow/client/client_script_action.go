@@ -0,0 +1,15 @@
+package client
+
+// EndpointScriptAction is the endpoint to manage script action records.
+const EndpointScriptAction = "sysevent_script_action.do"
+
+// ScriptAction is the json response for a script action in ServiceNow.
+type ScriptAction struct {
+	BaseResult
+	Name            string `json:"name"`
+	EventName       string `json:"event_name"`
+	ConditionScript string `json:"condition_script"`
+	Script          string `json:"script"`
+	Order           int    `json:"order,string"`
+	Active          bool   `json:"active,string"`
+}
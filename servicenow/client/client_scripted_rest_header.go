@@ -0,0 +1,23 @@
+package client
+
+// EndpointScriptedRestHeader is the endpoint to manage Scripted Rest request header records.
+const EndpointScriptedRestHeader = "sys_ws_header.do"
+
+// EndpointScriptedRestHeaderRelation is the endpoint to manage the relations between Scripted Rest Resources and request headers.
+const EndpointScriptedRestHeaderRelation = "sys_ws_header_map.do"
+
+// ScriptedRestHeader is the json response for a request header of Scripted Rest Resources in ServiceNow.
+type ScriptedRestHeader struct {
+	BaseResult
+	Name             string `json:"name"`
+	ShortDescription string `json:"short_description"`
+	Required         bool   `json:"required,string"`
+	ExampleValue     string `json:"example_value"`
+}
+
+// ScriptedRestHeaderRelation is the json response for a relation between a Scripted Rest Resource and a request header in ServiceNow.
+type ScriptedRestHeaderRelation struct {
+	BaseResult
+	ResourceID string `json:"web_service_operation"`
+	HeaderID   string `json:"web_service_header"`
+}
@@ -0,0 +1,23 @@
+package client
+
+// EndpointScriptedRestQueryParameter is the endpoint to manage Scripted Rest query parameter records.
+const EndpointScriptedRestQueryParameter = "sys_ws_query_parameter.do"
+
+// EndpointScriptedRestQueryParameterRelation is the endpoint to manage the relations between Scripted Rest Resources and query parameters.
+const EndpointScriptedRestQueryParameterRelation = "sys_ws_query_parameter_map.do"
+
+// ScriptedRestQueryParameter is the json response for a query parameter of Scripted Rest Resources in ServiceNow.
+type ScriptedRestQueryParameter struct {
+	BaseResult
+	Name             string `json:"name"`
+	ShortDescription string `json:"short_description"`
+	Required         bool   `json:"required,string"`
+	ExampleValue     string `json:"example_value"`
+}
+
+// ScriptedRestQueryParameterRelation is the json response for a relation between a Scripted Rest Resource and a query parameter in ServiceNow.
+type ScriptedRestQueryParameterRelation struct {
+	BaseResult
+	ResourceID       string `json:"web_service_operation"`
+	QueryParameterID string `json:"web_service_query_parameter"`
+}
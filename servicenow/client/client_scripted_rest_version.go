@@ -0,0 +1,15 @@
+package client
+
+// EndpointScriptedRestVersion is the endpoint to manage Scripted Rest Api version records.
+const EndpointScriptedRestVersion = "sys_ws_version.do"
+
+// ScriptedRestVersion is the json response for a version of a Scripted Rest Api in ServiceNow.
+type ScriptedRestVersion struct {
+	BaseResult
+	WebServiceDefinition string `json:"web_service_definition"`
+	Version              int    `json:"version,string"`
+	Active               bool   `json:"active,string"`
+	IsDefault            bool   `json:"is_default,string"`
+	Deprecated           bool   `json:"deprecated,string"`
+	ShortDescription     string `json:"short_description"`
+}
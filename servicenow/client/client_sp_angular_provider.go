@@ -0,0 +1,12 @@
+package client
+
+// EndpointSPAngularProvider is the endpoint to manage angular provider records.
+const EndpointSPAngularProvider = "sp_angular_provider.do"
+
+// SPAngularProvider represents the json response for an Angular Provider in ServiceNow.
+type SPAngularProvider struct {
+	BaseResult
+	Name   string `json:"name"`
+	Type   string `json:"type"`
+	Script string `json:"script"`
+}
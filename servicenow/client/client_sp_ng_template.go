@@ -0,0 +1,12 @@
+package client
+
+// EndpointSPNgTemplate is the endpoint to manage angular ng-template records.
+const EndpointSPNgTemplate = "sp_ng_template.do"
+
+// SPNgTemplate represents the json response for an Angular ng-template in ServiceNow.
+type SPNgTemplate struct {
+	BaseResult
+	CustomID string `json:"id"`
+	WidgetID string `json:"sp_widget"`
+	Template string `json:"template"`
+}
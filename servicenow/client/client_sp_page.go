@@ -0,0 +1,70 @@
+package client
+
+// EndpointSPPage is the endpoint to manage service portal page records.
+const EndpointSPPage = "sp_page.do"
+
+// EndpointSPContainer is the endpoint to manage service portal container records.
+const EndpointSPContainer = "sp_container.do"
+
+// EndpointSPRow is the endpoint to manage service portal row records.
+const EndpointSPRow = "sp_row.do"
+
+// EndpointSPColumn is the endpoint to manage service portal column records.
+const EndpointSPColumn = "sp_column.do"
+
+// EndpointSPInstance is the endpoint to manage service portal widget instance records.
+const EndpointSPInstance = "sp_instance.do"
+
+// SPPage is the json response for a service portal page in ServiceNow.
+type SPPage struct {
+	BaseResult
+	CustomID         string `json:"id"`
+	Title            string `json:"title"`
+	ShortDescription string `json:"short_description"`
+	Public           bool   `json:"public,string"`
+	Roles            string `json:"roles"`
+	CSS              string `json:"css"`
+}
+
+// SPContainer is the json response for a container of a service portal page in ServiceNow.
+type SPContainer struct {
+	BaseResult
+	PageID          string `json:"sp_page"`
+	Order           int    `json:"order,string"`
+	Width           string `json:"width"`
+	BackgroundColor string `json:"background_color"`
+	ClassName       string `json:"class_name"`
+}
+
+// SPRow is the json response for a row of a service portal container in ServiceNow.
+type SPRow struct {
+	BaseResult
+	ContainerID string `json:"sp_container"`
+	Order       int    `json:"order,string"`
+	ClassName   string `json:"class_name"`
+}
+
+// SPColumn is the json response for a column of a service portal row in ServiceNow.
+type SPColumn struct {
+	BaseResult
+	RowID     string `json:"sp_row"`
+	Order     int    `json:"order,string"`
+	SizeXS    string `json:"size_xs"`
+	SizeSM    string `json:"size_sm"`
+	SizeMD    string `json:"size"`
+	SizeLG    string `json:"size_lg"`
+	ClassName string `json:"class_name"`
+}
+
+// SPInstance is the json response for a widget instance placed in a service portal column in ServiceNow.
+type SPInstance struct {
+	BaseResult
+	ColumnID  string `json:"sp_column"`
+	WidgetID  string `json:"sp_widget"`
+	Order     int    `json:"order,string"`
+	Title     string `json:"title"`
+	Options   string `json:"widget_parameters"`
+	CSS       string `json:"css"`
+	Roles     string `json:"roles"`
+	ClassName string `json:"class_name"`
+}
@@ -0,0 +1,19 @@
+package client
+
+// EndpointSPPortal is the endpoint to manage service portal records.
+const EndpointSPPortal = "sp_portal.do"
+
+// SPPortal represents the json response for a Service Portal in ServiceNow.
+type SPPortal struct {
+	BaseResult
+	Title             string `json:"title"`
+	URLSuffix         string `json:"url_suffix"`
+	HomePageID        string `json:"homepage"`
+	NotFoundPageID    string `json:"notfound_page"`
+	LoginPageID       string `json:"login_page"`
+	KBHomePageID      string `json:"kb_knowledge_page"`
+	CatalogHomePageID string `json:"sc_catalog_page"`
+	ThemeID           string `json:"theme"`
+	Logo              string `json:"logo"`
+	Default           bool   `json:"default,string"`
+}
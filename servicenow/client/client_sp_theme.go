@@ -0,0 +1,15 @@
+package client
+
+// EndpointSPTheme is the endpoint to manage service portal theme records.
+const EndpointSPTheme = "sp_theme.do"
+
+// SPTheme represents the json response for a Service Portal Theme in ServiceNow.
+type SPTheme struct {
+	BaseResult
+	Name         string `json:"name"`
+	HeaderID     string `json:"header"`
+	FooterID     string `json:"footer"`
+	CSSVariables string `json:"css_variables"`
+	FixedHeader  bool   `json:"navbar_fixed,string"`
+	FixedFooter  bool   `json:"footer_fixed,string"`
+}
@@ -0,0 +1,12 @@
+package client
+
+// EndpointSPThemeCSSInclude is the endpoint to manage theme CSS include relation records.
+const EndpointSPThemeCSSInclude = "m2m_sp_theme_css_include.do"
+
+// SPThemeCSSInclude represents the json response for a relation between a theme and a CSS include in ServiceNow.
+type SPThemeCSSInclude struct {
+	BaseResult
+	ThemeID      string `json:"sp_theme"`
+	CSSIncludeID string `json:"sp_css_include"`
+	Order        int    `json:"order,string"`
+}
@@ -0,0 +1,19 @@
+package client
+
+// EndpointTransformMap is the endpoint to manage transform map records.
+const EndpointTransformMap = "sys_transform_map.do"
+
+// TransformMap represents the json response for a Transform Map in ServiceNow.
+type TransformMap struct {
+	BaseResult
+	Name                   string `json:"name"`
+	SourceTable            string `json:"source_table"`
+	TargetTable            string `json:"target_table"`
+	Active                 bool   `json:"active,string"`
+	RunBusinessRules       bool   `json:"run_business_rules,string"`
+	EnforceMandatoryFields string `json:"enforce_mandatory_fields"`
+	CopyEmptyFields        bool   `json:"copy_empty_fields,string"`
+	Order                  int    `json:"order,string"`
+	RunScript              bool   `json:"run_script,string"`
+	Script                 string `json:"script"`
+}
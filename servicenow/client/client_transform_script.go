@@ -0,0 +1,14 @@
+package client
+
+// EndpointTransformScript is the endpoint to manage transform script records.
+const EndpointTransformScript = "sys_transform_script.do"
+
+// TransformScript represents the json response for a Transform Script in ServiceNow.
+type TransformScript struct {
+	BaseResult
+	TransformMapID string `json:"map"`
+	When           string `json:"when"`
+	Order          int    `json:"order,string"`
+	Active         bool   `json:"active,string"`
+	Script         string `json:"script"`
+}
@@ -0,0 +1,20 @@
+package client
+
+// EndpointUser is the endpoint to manage user records.
+const EndpointUser = "sys_user.do"
+
+// User is the json response for a user in ServiceNow.
+type User struct {
+	BaseResult
+	UserName             string `json:"user_name"`
+	FirstName            string `json:"first_name"`
+	LastName             string `json:"last_name"`
+	Name                 string `json:"name,omitempty"`
+	Email                string `json:"email"`
+	Title                string `json:"title"`
+	Password             string `json:"user_password,omitempty"`
+	PasswordNeedsReset   bool   `json:"password_needs_reset,string"`
+	WebServiceAccessOnly bool   `json:"web_service_access_only,string"`
+	LockedOut            bool   `json:"locked_out,string"`
+	Active               bool   `json:"active,string"`
+}
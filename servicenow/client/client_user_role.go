@@ -0,0 +1,11 @@
+package client
+
+// EndpointUserRole is the endpoint to manage role grants on users.
+const EndpointUserRole = "sys_user_has_role.do"
+
+// UserRole represents the json response for a role granted to a user in ServiceNow.
+type UserRole struct {
+	BaseResult
+	UserID string `json:"user"`
+	RoleID string `json:"role"`
+}
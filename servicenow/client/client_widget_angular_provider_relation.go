@@ -0,0 +1,11 @@
+package client
+
+// EndpointWidgetAngularProviderRelation is the endpoint to manage widget angular provider relation records.
+const EndpointWidgetAngularProviderRelation = "m2m_sp_widget_angular_provider.do"
+
+// WidgetAngularProviderRelation represents the json response for a widget angular provider relation in ServiceNow.
+type WidgetAngularProviderRelation struct {
+	BaseResult
+	AngularProviderID string `json:"sp_angular_provider"`
+	WidgetID          string `json:"sp_widget"`
+}
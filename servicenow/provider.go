@@ -28,43 +28,98 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"servicenow_application":                resources.ResourceApplication(),
-			"servicenow_application_menu":           resources.ResourceApplicationMenu(),
-			"servicenow_application_module":         resources.ResourceApplicationModule(),
-			"servicenow_content_css":                resources.ResourceContentCSS(),
-			"servicenow_css_include":                resources.ResourceCSSInclude(),
-			"servicenow_css_include_relation":       resources.ResourceCSSIncludeRelation(),
-			"servicenow_db_table":                   resources.ResourceDBTable(),
-			"servicenow_extension_point":            resources.ResourceExtensionPoint(),
-			"servicenow_js_include":                 resources.ResourceJsInclude(),
-			"servicenow_js_include_relation":        resources.ResourceJsIncludeRelation(),
-			"servicenow_oauth_entity":               resources.ResourceOAuthEntity(),
-			"servicenow_role":                       resources.ResourceRole(),
-			"servicenow_rest_message":               resources.ResourceRestMessage(),
-			"servicenow_rest_message_header":        resources.ResourceRestMessageHeader(),
-			"servicenow_rest_method":                resources.ResourceRestMethod(),
-			"servicenow_rest_method_header":         resources.ResourceRestMethodHeader(),
-			"servicenow_scripted_rest_api":          resources.ResourceScriptedRestApi(),
-			"servicenow_scripted_rest_resource":     resources.ResourceScriptedRestResource(),
-			"servicenow_script_include":             resources.ResourceScriptInclude(),
-			"servicenow_system_property":            resources.ResourceSystemProperty(),
-			"servicenow_system_property_category":   resources.ResourceSystemPropertyCategory(),
-			"servicenow_system_property_relation":   resources.ResourceSystemPropertyRelation(),
-			"servicenow_ui_macro":                   resources.ResourceUIMacro(),
-			"servicenow_ui_page":                    resources.ResourceUIPage(),
-			"servicenow_ui_script":                  resources.ResourceUIScript(),
-			"servicenow_widget":                     resources.ResourceWidget(),
-			"servicenow_widget_dependency":          resources.ResourceWidgetDependency(),
-			"servicenow_widget_dependency_relation": resources.ResourceWidgetDependencyRelation(),
+			"servicenow_application":                            resources.ResourceApplication(),
+			"servicenow_application_menu":                       resources.ResourceApplicationMenu(),
+			"servicenow_application_module":                     resources.ResourceApplicationModule(),
+			"servicenow_basic_auth_profile":                     resources.ResourceBasicAuthProfile(),
+			"servicenow_catalog_category":                       resources.ResourceCatalogCategory(),
+			"servicenow_catalog_client_script":                  resources.ResourceCatalogClientScript(),
+			"servicenow_catalog_item":                           resources.ResourceCatalogItem(),
+			"servicenow_catalog_ui_policy":                      resources.ResourceCatalogUIPolicy(),
+			"servicenow_catalog_variable":                       resources.ResourceCatalogVariable(),
+			"servicenow_choice":                                 resources.ResourceChoice(),
+			"servicenow_choice_set":                             resources.ResourceChoiceSet(),
+			"servicenow_content_css":                            resources.ResourceContentCSS(),
+			"servicenow_css_include":                            resources.ResourceCSSInclude(),
+			"servicenow_css_include_relation":                   resources.ResourceCSSIncludeRelation(),
+			"servicenow_data_source":                            resources.ResourceDataSource(),
+			"servicenow_db_column":                              resources.ResourceDBColumn(),
+			"servicenow_db_table":                               resources.ResourceDBTable(),
+			"servicenow_db_view":                                resources.ResourceDBView(),
+			"servicenow_dictionary_override":                    resources.ResourceDictionaryOverride(),
+			"servicenow_email_script":                           resources.ResourceEmailScript(),
+			"servicenow_email_template":                         resources.ResourceEmailTemplate(),
+			"servicenow_event":                                  resources.ResourceEvent(),
+			"servicenow_extension_point":                        resources.ResourceExtensionPoint(),
+			"servicenow_field_map":                              resources.ResourceFieldMap(),
+			"servicenow_group":                                  resources.ResourceGroup(),
+			"servicenow_group_member":                           resources.ResourceGroupMember(),
+			"servicenow_group_role":                             resources.ResourceGroupRole(),
+			"servicenow_inbound_email_action":                   resources.ResourceInboundEmailAction(),
+			"servicenow_js_include":                             resources.ResourceJsInclude(),
+			"servicenow_js_include_relation":                    resources.ResourceJsIncludeRelation(),
+			"servicenow_jwt_keystore_alias":                     resources.ResourceJWTKeystoreAlias(),
+			"servicenow_jwt_provider":                           resources.ResourceJWTProvider(),
+			"servicenow_notification":                           resources.ResourceNotification(),
+			"servicenow_oauth_entity":                           resources.ResourceOAuthEntity(),
+			"servicenow_oauth_entity_profile":                   resources.ResourceOAuthEntityProfile(),
+			"servicenow_oauth_entity_scope":                     resources.ResourceOAuthEntityScope(),
+			"servicenow_protocol_profile":                       resources.ResourceProtocolProfile(),
+			"servicenow_record_producer":                        resources.ResourceRecordProducer(),
+			"servicenow_relationship":                           resources.ResourceRelationship(),
+			"servicenow_role":                                   resources.ResourceRole(),
+			"servicenow_rest_api_access_policy":                 resources.ResourceRestAPIAccessPolicy(),
+			"servicenow_rest_message":                           resources.ResourceRestMessage(),
+			"servicenow_rest_message_header":                    resources.ResourceRestMessageHeader(),
+			"servicenow_rest_method":                            resources.ResourceRestMethod(),
+			"servicenow_rest_method_header":                     resources.ResourceRestMethodHeader(),
+			"servicenow_rest_method_parameter":                  resources.ResourceRestMethodParameter(),
+			"servicenow_rest_method_query_parameter":            resources.ResourceRestMethodQueryParameter(),
+			"servicenow_rest_rate_limit":                        resources.ResourceRestRateLimit(),
+			"servicenow_scheduled_import":                       resources.ResourceScheduledImport(),
+			"servicenow_scheduled_job":                          resources.ResourceScheduledJob(),
+			"servicenow_script_action":                          resources.ResourceScriptAction(),
+			"servicenow_scripted_rest_api":                      resources.ResourceScriptedRestApi(),
+			"servicenow_scripted_rest_api_from_openapi":         resources.ResourceScriptedRestAPIFromOpenAPI(),
+			"servicenow_scripted_rest_header":                   resources.ResourceScriptedRestHeader(),
+			"servicenow_scripted_rest_header_relation":          resources.ResourceScriptedRestHeaderRelation(),
+			"servicenow_scripted_rest_query_parameter":          resources.ResourceScriptedRestQueryParameter(),
+			"servicenow_scripted_rest_query_parameter_relation": resources.ResourceScriptedRestQueryParameterRelation(),
+			"servicenow_scripted_rest_resource":                 resources.ResourceScriptedRestResource(),
+			"servicenow_script_include":                         resources.ResourceScriptInclude(),
+			"servicenow_scripted_rest_version":                  resources.ResourceScriptedRestVersion(),
+			"servicenow_sp_angular_provider":                    resources.ResourceSPAngularProvider(),
+			"servicenow_sp_ng_template":                         resources.ResourceSPNgTemplate(),
+			"servicenow_sp_page":                                resources.ResourceSPPage(),
+			"servicenow_sp_portal":                              resources.ResourceSPPortal(),
+			"servicenow_sp_theme":                               resources.ResourceSPTheme(),
+			"servicenow_sp_theme_css_include":                   resources.ResourceSPThemeCSSInclude(),
+			"servicenow_system_property":                        resources.ResourceSystemProperty(),
+			"servicenow_system_property_category":               resources.ResourceSystemPropertyCategory(),
+			"servicenow_system_property_relation":               resources.ResourceSystemPropertyRelation(),
+			"servicenow_transform_map":                          resources.ResourceTransformMap(),
+			"servicenow_transform_script":                       resources.ResourceTransformScript(),
+			"servicenow_ui_macro":                               resources.ResourceUIMacro(),
+			"servicenow_ui_page":                                resources.ResourceUIPage(),
+			"servicenow_ui_script":                              resources.ResourceUIScript(),
+			"servicenow_user":                                   resources.ResourceUser(),
+			"servicenow_user_role":                              resources.ResourceUserRole(),
+			"servicenow_widget":                                 resources.ResourceWidget(),
+			"servicenow_widget_angular_provider_relation":       resources.ResourceWidgetAngularProviderRelation(),
+			"servicenow_widget_dependency":                      resources.ResourceWidgetDependency(),
+			"servicenow_widget_dependency_relation":             resources.ResourceWidgetDependencyRelation(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"servicenow_acl":                      resources.DataSourceACL(),
-			"servicenow_application":              resources.DataSourceApplication(),
-			"servicenow_application_category":     resources.DataSourceApplicationCategory(),
-			"servicenow_db_table":                 resources.DataSourceDBTable(),
-			"servicenow_role":                     resources.DataSourceRole(),
-			"servicenow_system_property":          resources.DataSourceSystemProperty(),
-			"servicenow_system_property_category": resources.DataSourceSystemPropertyCategory(),
+			"servicenow_acl":                       resources.DataSourceACL(),
+			"servicenow_application":               resources.DataSourceApplication(),
+			"servicenow_application_category":      resources.DataSourceApplicationCategory(),
+			"servicenow_db_table":                  resources.DataSourceDBTable(),
+			"servicenow_group":                     resources.DataSourceGroup(),
+			"servicenow_role":                      resources.DataSourceRole(),
+			"servicenow_scripted_rest_api_openapi": resources.DataSourceScriptedRestAPIOpenAPI(),
+			"servicenow_system_property":           resources.DataSourceSystemProperty(),
+			"servicenow_system_property_category":  resources.DataSourceSystemPropertyCategory(),
+			"servicenow_user":                      resources.DataSourceUser(),
 		},
 		ConfigureFunc: configure,
 	}
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -28,6 +29,15 @@ func getScopeSchema() *schema.Schema {
 	}
 }
 
+// getWriteOnlyValue returns the value of a secret attribute when it was modified, or an empty string otherwise.
+// Secrets are stored hashed or encrypted on the instance, so they are never read back and only sent when they change.
+func getWriteOnlyValue(data *schema.ResourceData, key string) string {
+	if data.HasChange(key) {
+		return data.Get(key).(string)
+	}
+	return ""
+}
+
 // setOnlyRequiredSchema Changes required parameters. For data sources, only one attribute is normally required and everything else is computed.
 func setOnlyRequiredSchema(schema map[string]*schema.Schema, requiredName string) {
 	for key, val := range schema {
@@ -61,3 +71,20 @@ func validateStringValue(actual string, key string, expectedValues []string) (wa
 	errs = append(errs, fmt.Errorf("%q must be %s, got: %s", key, message, actual))
 	return
 }
+
+// atoiOrZero converts a numeric string received from ServiceNow to an int. Empty or invalid values are 0.
+func atoiOrZero(value string) int {
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0
+	}
+	return parsed
+}
+
+// itoaOrEmpty converts an int to a numeric string for ServiceNow. The value 0 is sent as an empty string.
+func itoaOrEmpty(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
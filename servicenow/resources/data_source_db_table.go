@@ -25,7 +25,5 @@ func readDataSourceDBTable(data *schema.ResourceData, serviceNowClient interface
 		return err
 	}
 
-	resourceFromDBTable(data, dbTable)
-
-	return nil
+	return resourceFromDBTableWithReferences(data, dbTable, snowClient)
 }
@@ -0,0 +1,31 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DataSourceGroup reads the informations about a single User Group in ServiceNow.
+func DataSourceGroup() *schema.Resource {
+	// Copy the schema from the resource.
+	resourceSchema := ResourceGroup().Schema
+	setOnlyRequiredSchema(resourceSchema, groupName)
+
+	return &schema.Resource{
+		Read:   readDataSourceGroup,
+		Schema: resourceSchema,
+	}
+}
+
+func readDataSourceGroup(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	group := &client.Group{}
+	if err := snowClient.GetObjectByName(client.EndpointGroup, data.Get(groupName).(string), group); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromGroup(data, group)
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package resources
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestAPIOpenAPIAPIID = "api_id"
+const scriptedRestAPIOpenAPIDocument = "document"
+
+var openAPIPathParameterRegex = regexp.MustCompile(`{([^}]+)}`)
+
+type openAPIDocument struct {
+	OpenAPI string                                  `json:"openapi"`
+	Info    openAPIInfo                             `json:"info"`
+	Paths   map[string]map[string]*openAPIOperation `json:"paths"`
+}
+
+type openAPIInfo struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+type openAPIOperation struct {
+	OperationID string                     `json:"operationId,omitempty"`
+	Summary     string                     `json:"summary,omitempty"`
+	Deprecated  bool                       `json:"deprecated,omitempty"`
+	Parameters  []openAPIParameter         `json:"parameters,omitempty"`
+	RequestBody *openAPIRequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]openAPIResponse `json:"responses"`
+}
+
+type openAPIParameter struct {
+	Name        string        `json:"name"`
+	In          string        `json:"in"`
+	Description string        `json:"description,omitempty"`
+	Required    bool          `json:"required,omitempty"`
+	Example     string        `json:"example,omitempty"`
+	Schema      openAPISchema `json:"schema"`
+}
+
+type openAPIRequestBody struct {
+	Content map[string]openAPIMediaType `json:"content"`
+}
+
+type openAPIResponse struct {
+	Description string                      `json:"description"`
+	Content     map[string]openAPIMediaType `json:"content,omitempty"`
+}
+
+type openAPIMediaType struct {
+	Schema openAPISchema `json:"schema"`
+}
+
+type openAPISchema struct {
+	Type string `json:"type,omitempty"`
+}
+
+// DataSourceScriptedRestAPIOpenAPI generates the OpenAPI 3 document describing a Scripted REST API.
+func DataSourceScriptedRestAPIOpenAPI() *schema.Resource {
+	return &schema.Resource{
+		Read: readDataSourceScriptedRestAPIOpenAPI,
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestAPIOpenAPIAPIID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The sys_id of the Scripted REST API to document.",
+			},
+			scriptedRestAPIOpenAPIDocument: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The OpenAPI 3 document describing the API, as JSON.",
+			},
+		},
+	}
+}
+
+func readDataSourceScriptedRestAPIOpenAPI(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	apiID := data.Get(scriptedRestAPIOpenAPIAPIID).(string)
+	api := &client.ScriptedRestApi{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestApi, apiID, api); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	document, err := scriptedRestAPIToOpenAPI(snowClient, api)
+	if err != nil {
+		return err
+	}
+
+	documentJSON, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	data.SetId(api.ID)
+	data.Set(scriptedRestAPIOpenAPIDocument, string(documentJSON))
+
+	return nil
+}
+
+func scriptedRestAPIToOpenAPI(snowClient client.ServiceNowClient, api *client.ScriptedRestApi) (*openAPIDocument, error) {
+	versions := []client.ScriptedRestVersion{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointScriptedRestVersion, "web_service_definition="+api.ID, &versions); err != nil {
+		return nil, err
+	}
+
+	document := &openAPIDocument{
+		OpenAPI: "3.0.3",
+		Info: openAPIInfo{
+			Title:       api.Name,
+			Description: api.ShortDescription,
+			Version:     "1.0.0",
+		},
+		Paths: map[string]map[string]*openAPIOperation{},
+	}
+
+	versionsByID := map[string]client.ScriptedRestVersion{}
+	for _, version := range versions {
+		versionsByID[version.ID] = version
+		if version.IsDefault {
+			document.Info.Version = fmt.Sprintf("v%d", version.Version)
+		}
+	}
+
+	scriptedRestResources := []client.ScriptedRestResource{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointScriptedRestResource, "web_service_definition="+api.ID+"^active=true", &scriptedRestResources); err != nil {
+		return nil, err
+	}
+
+	for _, resource := range scriptedRestResources {
+		// The operation URI is resolved by ServiceNow, including the namespace, version and service ID.
+		path := resource.OperationURI
+		version, versioned := versionsByID[resource.WebServiceVersion]
+
+		operation, err := scriptedRestResourceToOpenAPIOperation(snowClient, &resource, path)
+		if err != nil {
+			return nil, err
+		}
+		operation.Deprecated = versioned && version.Deprecated
+
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]*openAPIOperation{}
+		}
+		document.Paths[path][strings.ToLower(resource.HTTPMethod)] = operation
+	}
+
+	return document, nil
+}
+
+func scriptedRestResourceToOpenAPIOperation(snowClient client.ServiceNowClient, resource *client.ScriptedRestResource, path string) (*openAPIOperation, error) {
+	operation := &openAPIOperation{
+		OperationID: resource.Name,
+		Summary:     resource.ShortDescription,
+		Parameters:  []openAPIParameter{},
+		Responses: map[string]openAPIResponse{
+			"200": {Description: "Successful response", Content: openAPIContent(resource.Produces)},
+		},
+	}
+
+	for _, match := range openAPIPathParameterRegex.FindAllStringSubmatch(path, -1) {
+		operation.Parameters = append(operation.Parameters, openAPIParameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   openAPISchema{Type: "string"},
+		})
+	}
+
+	queryParameterRelations := []client.ScriptedRestQueryParameterRelation{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointScriptedRestQueryParameterRelation, "web_service_operation="+resource.ID, &queryParameterRelations); err != nil {
+		return nil, err
+	}
+	for _, relation := range queryParameterRelations {
+		queryParameter := &client.ScriptedRestQueryParameter{}
+		if err := snowClient.GetObject(client.EndpointScriptedRestQueryParameter, relation.QueryParameterID, queryParameter); err != nil {
+			return nil, err
+		}
+		operation.Parameters = append(operation.Parameters, openAPIParameter{
+			Name:        queryParameter.Name,
+			In:          "query",
+			Description: queryParameter.ShortDescription,
+			Required:    queryParameter.Required,
+			Example:     queryParameter.ExampleValue,
+			Schema:      openAPISchema{Type: "string"},
+		})
+	}
+
+	headerRelations := []client.ScriptedRestHeaderRelation{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointScriptedRestHeaderRelation, "web_service_operation="+resource.ID, &headerRelations); err != nil {
+		return nil, err
+	}
+	for _, relation := range headerRelations {
+		header := &client.ScriptedRestHeader{}
+		if err := snowClient.GetObject(client.EndpointScriptedRestHeader, relation.HeaderID, header); err != nil {
+			return nil, err
+		}
+		operation.Parameters = append(operation.Parameters, openAPIParameter{
+			Name:        header.Name,
+			In:          "header",
+			Description: header.ShortDescription,
+			Required:    header.Required,
+			Example:     header.ExampleValue,
+			Schema:      openAPISchema{Type: "string"},
+		})
+	}
+
+	switch resource.HTTPMethod {
+	case "POST", "PUT", "PATCH":
+		operation.RequestBody = &openAPIRequestBody{Content: openAPIContent(resource.Consumes)}
+	}
+
+	return operation, nil
+}
+
+// openAPIContent maps a comma-separated list of MIME types to OpenAPI media types.
+func openAPIContent(mimeTypes string) map[string]openAPIMediaType {
+	content := map[string]openAPIMediaType{}
+	for _, mimeType := range strings.Split(mimeTypes, ",") {
+		if mimeType = strings.TrimSpace(mimeType); mimeType != "" {
+			content[mimeType] = openAPIMediaType{Schema: openAPISchema{Type: "object"}}
+		}
+	}
+	if len(content) == 0 {
+		content["application/json"] = openAPIMediaType{Schema: openAPISchema{Type: "object"}}
+	}
+	return content
+}
@@ -0,0 +1,32 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// DataSourceUser reads the informations about a single User in ServiceNow.
+func DataSourceUser() *schema.Resource {
+	// Copy the schema from the resource.
+	resourceSchema := ResourceUser().Schema
+	delete(resourceSchema, userPassword)
+	setOnlyRequiredSchema(resourceSchema, userUserName)
+
+	return &schema.Resource{
+		Read:   readDataSourceUser,
+		Schema: resourceSchema,
+	}
+}
+
+func readDataSourceUser(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	user := &client.User{}
+	if err := snowClient.GetObjectByQuery(client.EndpointUser, userUserName+"="+data.Get(userUserName).(string), user); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromUser(data, user)
+
+	return nil
+}
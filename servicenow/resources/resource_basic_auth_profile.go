@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const basicAuthProfileName = "name"
+const basicAuthProfileUserName = "username"
+const basicAuthProfilePassword = "password"
+
+// ResourceBasicAuthProfile is holding the credentials used by outbound requests with basic authentication.
+func ResourceBasicAuthProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceBasicAuthProfile,
+		Read:   readResourceBasicAuthProfile,
+		Update: updateResourceBasicAuthProfile,
+		Delete: deleteResourceBasicAuthProfile,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			basicAuthProfileName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			basicAuthProfileUserName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			basicAuthProfilePassword: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the basic auth profile.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceBasicAuthProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	basicAuthProfile := &client.BasicAuthProfile{}
+	if err := snowClient.GetObject(client.EndpointBasicAuthProfile, data.Id(), basicAuthProfile); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromBasicAuthProfile(data, basicAuthProfile)
+
+	return nil
+}
+
+func createResourceBasicAuthProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	basicAuthProfile := resourceToBasicAuthProfile(data)
+	if err := snowClient.CreateObject(client.EndpointBasicAuthProfile, basicAuthProfile); err != nil {
+		return err
+	}
+
+	resourceFromBasicAuthProfile(data, basicAuthProfile)
+
+	return readResourceBasicAuthProfile(data, serviceNowClient)
+}
+
+func updateResourceBasicAuthProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointBasicAuthProfile, resourceToBasicAuthProfile(data)); err != nil {
+		return err
+	}
+
+	return readResourceBasicAuthProfile(data, serviceNowClient)
+}
+
+func deleteResourceBasicAuthProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointBasicAuthProfile, data.Id())
+}
+
+func resourceFromBasicAuthProfile(data *schema.ResourceData, basicAuthProfile *client.BasicAuthProfile) {
+	data.SetId(basicAuthProfile.ID)
+	data.Set(basicAuthProfileName, basicAuthProfile.Name)
+	data.Set(basicAuthProfileUserName, basicAuthProfile.UserName)
+	data.Set(commonScope, basicAuthProfile.Scope)
+}
+
+func resourceToBasicAuthProfile(data *schema.ResourceData) *client.BasicAuthProfile {
+	basicAuthProfile := client.BasicAuthProfile{
+		Name:     data.Get(basicAuthProfileName).(string),
+		UserName: data.Get(basicAuthProfileUserName).(string),
+	}
+	basicAuthProfile.Password = getWriteOnlyValue(data, basicAuthProfilePassword)
+	basicAuthProfile.ID = data.Id()
+	basicAuthProfile.Scope = data.Get(commonScope).(string)
+	return &basicAuthProfile
+}
@@ -0,0 +1,126 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const catalogCategoryTitle = "title"
+const catalogCategoryDescription = "description"
+const catalogCategoryCatalogID = "catalog_id"
+const catalogCategoryParentID = "parent_id"
+const catalogCategoryActive = "active"
+const catalogCategoryRoles = "roles"
+
+// ResourceCatalogCategory is holding the info about a category of a Service Catalog.
+func ResourceCatalogCategory() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceCatalogCategory,
+		Read:   readResourceCatalogCategory,
+		Update: updateResourceCatalogCategory,
+		Delete: deleteResourceCatalogCategory,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			catalogCategoryTitle: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			catalogCategoryDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			catalogCategoryCatalogID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the catalog containing the category.",
+			},
+			catalogCategoryParentID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the parent category, when this category is nested.",
+			},
+			catalogCategoryActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			catalogCategoryRoles: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of Roles (names) that can see the category.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceCatalogCategory(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogCategory := &client.CatalogCategory{}
+	if err := snowClient.GetObject(client.EndpointCatalogCategory, data.Id(), catalogCategory); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromCatalogCategory(data, catalogCategory)
+
+	return nil
+}
+
+func createResourceCatalogCategory(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogCategory := resourceToCatalogCategory(data)
+	if err := snowClient.CreateObject(client.EndpointCatalogCategory, catalogCategory); err != nil {
+		return err
+	}
+
+	resourceFromCatalogCategory(data, catalogCategory)
+
+	return readResourceCatalogCategory(data, serviceNowClient)
+}
+
+func updateResourceCatalogCategory(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointCatalogCategory, resourceToCatalogCategory(data)); err != nil {
+		return err
+	}
+
+	return readResourceCatalogCategory(data, serviceNowClient)
+}
+
+func deleteResourceCatalogCategory(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointCatalogCategory, data.Id())
+}
+
+func resourceFromCatalogCategory(data *schema.ResourceData, catalogCategory *client.CatalogCategory) {
+	data.SetId(catalogCategory.ID)
+	data.Set(catalogCategoryTitle, catalogCategory.Title)
+	data.Set(catalogCategoryDescription, catalogCategory.Description)
+	data.Set(catalogCategoryCatalogID, catalogCategory.CatalogID)
+	data.Set(catalogCategoryParentID, catalogCategory.ParentID)
+	data.Set(catalogCategoryActive, catalogCategory.Active)
+	data.Set(catalogCategoryRoles, catalogCategory.Roles)
+	data.Set(commonScope, catalogCategory.Scope)
+}
+
+func resourceToCatalogCategory(data *schema.ResourceData) *client.CatalogCategory {
+	catalogCategory := client.CatalogCategory{
+		Title:       data.Get(catalogCategoryTitle).(string),
+		Description: data.Get(catalogCategoryDescription).(string),
+		CatalogID:   data.Get(catalogCategoryCatalogID).(string),
+		ParentID:    data.Get(catalogCategoryParentID).(string),
+		Active:      data.Get(catalogCategoryActive).(bool),
+		Roles:       data.Get(catalogCategoryRoles).(string),
+	}
+	catalogCategory.ID = data.Id()
+	catalogCategory.Scope = data.Get(commonScope).(string)
+	return &catalogCategory
+}
@@ -0,0 +1,183 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const catalogClientScriptName = "name"
+const catalogClientScriptCatalogItemID = "catalog_item_id"
+const catalogClientScriptType = "type"
+const catalogClientScriptVariableID = "variable_id"
+const catalogClientScriptUIType = "ui_type"
+const catalogClientScriptScript = "script"
+const catalogClientScriptActive = "active"
+const catalogClientScriptAppliesCatalogItem = "applies_on_catalog_item"
+const catalogClientScriptAppliesRequestedItem = "applies_on_requested_item"
+const catalogClientScriptAppliesCatalogTask = "applies_on_catalog_task"
+
+// catalogClientScriptUITypes maps the names accepted in the configuration to the UI type codes used by ServiceNow.
+var catalogClientScriptUITypes = map[string]string{
+	"desktop": "0",
+	"mobile":  "1",
+	"all":     "10",
+}
+
+// ResourceCatalogClientScript is holding the info about a client script running on the form of a catalog item.
+func ResourceCatalogClientScript() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceCatalogClientScript,
+		Read:   readResourceCatalogClientScript,
+		Update: updateResourceCatalogClientScript,
+		Delete: deleteResourceCatalogClientScript,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			catalogClientScriptName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			catalogClientScriptCatalogItemID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the catalog item or record producer running the script.",
+			},
+			catalogClientScriptType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "onLoad",
+				Description: "When the script runs. Can be 'onLoad', 'onChange' or 'onSubmit'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"onLoad", "onChange", "onSubmit"})
+					return
+				},
+			},
+			catalogClientScriptVariableID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is set to 'onChange'. The ID of the variable triggering the script.",
+			},
+			catalogClientScriptUIType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "all",
+				Description: "The interfaces running the script. Can be 'desktop', 'mobile' or 'all'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"desktop", "mobile", "all"})
+					return
+				},
+			},
+			catalogClientScriptScript: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			catalogClientScriptActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			catalogClientScriptAppliesCatalogItem: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the script runs when ordering the item.",
+			},
+			catalogClientScriptAppliesRequestedItem: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the script runs on the requested item form.",
+			},
+			catalogClientScriptAppliesCatalogTask: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the script runs on the catalog task form.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceCatalogClientScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogClientScript := &client.CatalogClientScript{}
+	if err := snowClient.GetObject(client.EndpointCatalogClientScript, data.Id(), catalogClientScript); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromCatalogClientScript(data, catalogClientScript)
+
+	return nil
+}
+
+func createResourceCatalogClientScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogClientScript := resourceToCatalogClientScript(data)
+	if err := snowClient.CreateObject(client.EndpointCatalogClientScript, catalogClientScript); err != nil {
+		return err
+	}
+
+	resourceFromCatalogClientScript(data, catalogClientScript)
+
+	return readResourceCatalogClientScript(data, serviceNowClient)
+}
+
+func updateResourceCatalogClientScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointCatalogClientScript, resourceToCatalogClientScript(data)); err != nil {
+		return err
+	}
+
+	return readResourceCatalogClientScript(data, serviceNowClient)
+}
+
+func deleteResourceCatalogClientScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointCatalogClientScript, data.Id())
+}
+
+func resourceFromCatalogClientScript(data *schema.ResourceData, catalogClientScript *client.CatalogClientScript) {
+	uiType := catalogClientScript.UIType
+	for name, code := range catalogClientScriptUITypes {
+		if code == catalogClientScript.UIType {
+			uiType = name
+		}
+	}
+
+	data.SetId(catalogClientScript.ID)
+	data.Set(catalogClientScriptName, catalogClientScript.Name)
+	data.Set(catalogClientScriptCatalogItemID, catalogClientScript.CatalogItemID)
+	data.Set(catalogClientScriptType, catalogClientScript.Type)
+	data.Set(catalogClientScriptVariableID, catalogVariableIDFromReference(catalogClientScript.Variable))
+	data.Set(catalogClientScriptUIType, uiType)
+	data.Set(catalogClientScriptScript, catalogClientScript.Script)
+	data.Set(catalogClientScriptActive, catalogClientScript.Active)
+	data.Set(catalogClientScriptAppliesCatalogItem, catalogClientScript.AppliesCatalogItem)
+	data.Set(catalogClientScriptAppliesRequestedItem, catalogClientScript.AppliesRequestedItem)
+	data.Set(catalogClientScriptAppliesCatalogTask, catalogClientScript.AppliesCatalogTask)
+	data.Set(commonScope, catalogClientScript.Scope)
+}
+
+func resourceToCatalogClientScript(data *schema.ResourceData) *client.CatalogClientScript {
+	catalogClientScript := client.CatalogClientScript{
+		Name:                 data.Get(catalogClientScriptName).(string),
+		CatalogItemID:        data.Get(catalogClientScriptCatalogItemID).(string),
+		Type:                 data.Get(catalogClientScriptType).(string),
+		Variable:             catalogVariableReference(data.Get(catalogClientScriptVariableID).(string)),
+		UIType:               catalogClientScriptUITypes[data.Get(catalogClientScriptUIType).(string)],
+		Script:               data.Get(catalogClientScriptScript).(string),
+		Active:               data.Get(catalogClientScriptActive).(bool),
+		AppliesCatalogItem:   data.Get(catalogClientScriptAppliesCatalogItem).(bool),
+		AppliesRequestedItem: data.Get(catalogClientScriptAppliesRequestedItem).(bool),
+		AppliesCatalogTask:   data.Get(catalogClientScriptAppliesCatalogTask).(bool),
+	}
+	catalogClientScript.ID = data.Id()
+	catalogClientScript.Scope = data.Get(commonScope).(string)
+	return &catalogClientScript
+}
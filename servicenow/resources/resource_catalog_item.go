@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const catalogItemName = "name"
+const catalogItemShortDescription = "short_description"
+const catalogItemDescription = "description"
+const catalogItemCatalogIDs = "catalog_ids"
+const catalogItemCategoryID = "category_id"
+const catalogItemAdditionalCategoryIDs = "additional_category_ids"
+const catalogItemWorkflowID = "workflow_id"
+const catalogItemFlowID = "flow_id"
+const catalogItemFulfillmentGroupID = "fulfillment_group_id"
+const catalogItemActive = "active"
+const catalogItemRoles = "roles"
+
+// ResourceCatalogItem is holding the info about an item that can be ordered from a Service Catalog.
+func ResourceCatalogItem() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceCatalogItem,
+		Read:   readResourceCatalogItem,
+		Update: updateResourceCatalogItem,
+		Delete: deleteResourceCatalogItem,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			catalogItemName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			catalogItemShortDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			catalogItemDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "HTML description displayed on the item page.",
+			},
+			catalogItemCatalogIDs: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Comma-separated list of the catalog IDs publishing the item.",
+			},
+			catalogItemCategoryID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the main category listing the item.",
+			},
+			catalogItemAdditionalCategoryIDs: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the other categories listing the item.",
+			},
+			catalogItemWorkflowID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{catalogItemFlowID},
+				Description:   "The ID of the workflow run when the item is requested.",
+			},
+			catalogItemFlowID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{catalogItemWorkflowID},
+				Description:   "The ID of the Flow Designer flow run when the item is requested.",
+			},
+			catalogItemFulfillmentGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the group fulfilling the requests for the item.",
+			},
+			catalogItemActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			catalogItemRoles: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of Roles (names) that can order the item.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceCatalogItem(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogItem := &client.CatalogItem{}
+	if err := snowClient.GetObject(client.EndpointCatalogItem, data.Id(), catalogItem); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	itemCategories := []client.CatalogItemCategory{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogItemCategory, "sc_cat_item="+data.Id(), &itemCategories); err != nil {
+		return err
+	}
+	categoryIDs := make([]string, 0, len(itemCategories))
+	for _, itemCategory := range itemCategories {
+		categoryIDs = append(categoryIDs, itemCategory.CategoryID)
+	}
+
+	resourceFromCatalogItem(data, catalogItem)
+	data.Set(catalogItemAdditionalCategoryIDs, categoryIDs)
+
+	return nil
+}
+
+func createResourceCatalogItem(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogItem := resourceToCatalogItem(data)
+	if err := snowClient.CreateObject(client.EndpointCatalogItem, catalogItem); err != nil {
+		return err
+	}
+
+	resourceFromCatalogItem(data, catalogItem)
+
+	if err := syncCatalogItemCategories(data, snowClient); err != nil {
+		return err
+	}
+
+	return readResourceCatalogItem(data, serviceNowClient)
+}
+
+func updateResourceCatalogItem(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointCatalogItem, resourceToCatalogItem(data)); err != nil {
+		return err
+	}
+
+	if err := syncCatalogItemCategories(data, snowClient); err != nil {
+		return err
+	}
+
+	return readResourceCatalogItem(data, serviceNowClient)
+}
+
+func deleteResourceCatalogItem(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	itemCategories := []client.CatalogItemCategory{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogItemCategory, "sc_cat_item="+data.Id(), &itemCategories); err != nil {
+		return err
+	}
+
+	for _, itemCategory := range itemCategories {
+		if err := snowClient.DeleteObject(client.EndpointCatalogItemCategory, itemCategory.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointCatalogItem, data.Id())
+}
+
+// syncCatalogItemCategories creates the missing additional categories of the item and deletes the ones
+// that are not declared anymore.
+func syncCatalogItemCategories(data *schema.ResourceData, snowClient client.ServiceNowClient) error {
+	existingCategories := []client.CatalogItemCategory{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogItemCategory, "sc_cat_item="+data.Id(), &existingCategories); err != nil {
+		return err
+	}
+
+	existingByCategory := map[string]client.CatalogItemCategory{}
+	for _, itemCategory := range existingCategories {
+		existingByCategory[itemCategory.CategoryID] = itemCategory
+	}
+
+	for _, categoryID := range data.Get(catalogItemAdditionalCategoryIDs).(*schema.Set).List() {
+		if _, ok := existingByCategory[categoryID.(string)]; ok {
+			delete(existingByCategory, categoryID.(string))
+			continue
+		}
+		itemCategory := &client.CatalogItemCategory{
+			CatalogItemID: data.Id(),
+			CategoryID:    categoryID.(string),
+		}
+		itemCategory.Scope = data.Get(commonScope).(string)
+		if err := snowClient.CreateObject(client.EndpointCatalogItemCategory, itemCategory); err != nil {
+			return err
+		}
+	}
+
+	for _, itemCategory := range existingByCategory {
+		if err := snowClient.DeleteObject(client.EndpointCatalogItemCategory, itemCategory.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFromCatalogItem(data *schema.ResourceData, catalogItem *client.CatalogItem) {
+	data.SetId(catalogItem.ID)
+	data.Set(catalogItemName, catalogItem.Name)
+	data.Set(catalogItemShortDescription, catalogItem.ShortDescription)
+	data.Set(catalogItemDescription, catalogItem.Description)
+	data.Set(catalogItemCatalogIDs, catalogItem.CatalogIDs)
+	data.Set(catalogItemCategoryID, catalogItem.CategoryID)
+	data.Set(catalogItemWorkflowID, catalogItem.WorkflowID)
+	data.Set(catalogItemFlowID, catalogItem.FlowID)
+	data.Set(catalogItemFulfillmentGroupID, catalogItem.FulfillmentGroupID)
+	data.Set(catalogItemActive, catalogItem.Active)
+	data.Set(catalogItemRoles, catalogItem.Roles)
+	data.Set(commonScope, catalogItem.Scope)
+}
+
+func resourceToCatalogItem(data *schema.ResourceData) *client.CatalogItem {
+	catalogItem := client.CatalogItem{
+		Name:               data.Get(catalogItemName).(string),
+		ShortDescription:   data.Get(catalogItemShortDescription).(string),
+		Description:        data.Get(catalogItemDescription).(string),
+		CatalogIDs:         data.Get(catalogItemCatalogIDs).(string),
+		CategoryID:         data.Get(catalogItemCategoryID).(string),
+		WorkflowID:         data.Get(catalogItemWorkflowID).(string),
+		FlowID:             data.Get(catalogItemFlowID).(string),
+		FulfillmentGroupID: data.Get(catalogItemFulfillmentGroupID).(string),
+		Active:             data.Get(catalogItemActive).(bool),
+		Roles:              data.Get(catalogItemRoles).(string),
+	}
+	catalogItem.ID = data.Id()
+	catalogItem.Scope = data.Get(commonScope).(string)
+	return &catalogItem
+}
@@ -0,0 +1,304 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const catalogUIPolicyShortDescription = "short_description"
+const catalogUIPolicyCatalogItemID = "catalog_item_id"
+const catalogUIPolicyConditions = "conditions"
+const catalogUIPolicyOrder = "order"
+const catalogUIPolicyOnLoad = "on_load"
+const catalogUIPolicyReverseIfFalse = "reverse_if_false"
+const catalogUIPolicyActive = "active"
+const catalogUIPolicyAppliesCatalogItem = "applies_on_catalog_item"
+const catalogUIPolicyAppliesRequestedItem = "applies_on_requested_item"
+const catalogUIPolicyAppliesCatalogTask = "applies_on_catalog_task"
+const catalogUIPolicyRunScripts = "run_scripts"
+const catalogUIPolicyScriptTrue = "script_true"
+const catalogUIPolicyScriptFalse = "script_false"
+const catalogUIPolicyAction = "action"
+const catalogUIPolicyActionVariableID = "variable_id"
+const catalogUIPolicyActionVisible = "visible"
+const catalogUIPolicyActionMandatory = "mandatory"
+const catalogUIPolicyActionReadOnly = "read_only"
+
+// ResourceCatalogUIPolicy is holding the info about a UI policy of a catalog item, along with its actions
+// on the variables. Actions are matched by variable and the ones that are not declared are removed.
+func ResourceCatalogUIPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceCatalogUIPolicy,
+		Read:   readResourceCatalogUIPolicy,
+		Update: updateResourceCatalogUIPolicy,
+		Delete: deleteResourceCatalogUIPolicy,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			catalogUIPolicyShortDescription: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			catalogUIPolicyCatalogItemID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the catalog item or record producer using the policy.",
+			},
+			catalogUIPolicyConditions: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Encoded query on the variables of the item, ex: 'IO:<variable_id>=true^EQ'. The policy always applies when empty.",
+			},
+			catalogUIPolicyOrder: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+			catalogUIPolicyOnLoad: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the policy is evaluated when the form loads.",
+			},
+			catalogUIPolicyReverseIfFalse: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the actions are reversed when the conditions are not met.",
+			},
+			catalogUIPolicyActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			catalogUIPolicyAppliesCatalogItem: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the policy applies when ordering the item.",
+			},
+			catalogUIPolicyAppliesRequestedItem: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the policy applies on the requested item form.",
+			},
+			catalogUIPolicyAppliesCatalogTask: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the policy applies on the catalog task form.",
+			},
+			catalogUIPolicyRunScripts: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether 'script_true' and 'script_false' are run.",
+			},
+			catalogUIPolicyScriptTrue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Client script run when the conditions are met.",
+			},
+			catalogUIPolicyScriptFalse: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Client script run when the conditions are not met.",
+			},
+			catalogUIPolicyAction: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The changes applied to the variables when the conditions are met.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						catalogUIPolicyActionVariableID: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the variable to change.",
+						},
+						catalogUIPolicyActionVisible:   getCatalogUIPolicyActionValueSchema("Whether the variable is displayed."),
+						catalogUIPolicyActionMandatory: getCatalogUIPolicyActionValueSchema("Whether the variable must be answered."),
+						catalogUIPolicyActionReadOnly:  getCatalogUIPolicyActionValueSchema("Whether the variable is read-only."),
+					},
+				},
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func getCatalogUIPolicyActionValueSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "ignore",
+		Description: description + " Can be 'true', 'false' or 'ignore' to leave it unchanged.",
+		ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+			warns, errs = validateStringValue(val.(string), key, []string{"true", "false", "ignore"})
+			return
+		},
+	}
+}
+
+func readResourceCatalogUIPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogUIPolicy := &client.CatalogUIPolicy{}
+	if err := snowClient.GetObject(client.EndpointCatalogUIPolicy, data.Id(), catalogUIPolicy); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	actions := []client.CatalogUIPolicyAction{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogUIPolicyAction, "ui_policy="+data.Id(), &actions); err != nil {
+		return err
+	}
+
+	resourceFromCatalogUIPolicy(data, catalogUIPolicy, actions)
+
+	return nil
+}
+
+func createResourceCatalogUIPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogUIPolicy := resourceToCatalogUIPolicy(data)
+	if err := snowClient.CreateObject(client.EndpointCatalogUIPolicy, catalogUIPolicy); err != nil {
+		return err
+	}
+
+	data.SetId(catalogUIPolicy.ID)
+
+	if err := syncCatalogUIPolicyActions(data, snowClient); err != nil {
+		return err
+	}
+
+	return readResourceCatalogUIPolicy(data, serviceNowClient)
+}
+
+func updateResourceCatalogUIPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointCatalogUIPolicy, resourceToCatalogUIPolicy(data)); err != nil {
+		return err
+	}
+
+	if data.HasChange(catalogUIPolicyAction) || data.HasChange(catalogUIPolicyCatalogItemID) {
+		if err := syncCatalogUIPolicyActions(data, snowClient); err != nil {
+			return err
+		}
+	}
+
+	return readResourceCatalogUIPolicy(data, serviceNowClient)
+}
+
+func deleteResourceCatalogUIPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	actions := []client.CatalogUIPolicyAction{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogUIPolicyAction, "ui_policy="+data.Id(), &actions); err != nil {
+		return err
+	}
+
+	for _, action := range actions {
+		if err := snowClient.DeleteObject(client.EndpointCatalogUIPolicyAction, action.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointCatalogUIPolicy, data.Id())
+}
+
+// syncCatalogUIPolicyActions updates the existing actions matching a declared variable, creates the missing
+// ones and deletes the ones that are not declared anymore.
+func syncCatalogUIPolicyActions(data *schema.ResourceData, snowClient client.ServiceNowClient) error {
+	existingActions := []client.CatalogUIPolicyAction{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogUIPolicyAction, "ui_policy="+data.Id(), &existingActions); err != nil {
+		return err
+	}
+
+	existingByVariable := map[string]client.CatalogUIPolicyAction{}
+	for _, action := range existingActions {
+		existingByVariable[action.Variable] = action
+	}
+
+	for _, item := range data.Get(catalogUIPolicyAction).(*schema.Set).List() {
+		values := item.(map[string]interface{})
+		action := &client.CatalogUIPolicyAction{
+			UIPolicyID:    data.Id(),
+			CatalogItemID: data.Get(catalogUIPolicyCatalogItemID).(string),
+			Variable:      catalogVariableReference(values[catalogUIPolicyActionVariableID].(string)),
+			Visible:       values[catalogUIPolicyActionVisible].(string),
+			Mandatory:     values[catalogUIPolicyActionMandatory].(string),
+			ReadOnly:      values[catalogUIPolicyActionReadOnly].(string),
+		}
+		action.Scope = data.Get(commonScope).(string)
+		if existing, ok := existingByVariable[action.Variable]; ok {
+			action.ID = existing.ID
+			if err := snowClient.UpdateObject(client.EndpointCatalogUIPolicyAction, action); err != nil {
+				return err
+			}
+			delete(existingByVariable, action.Variable)
+		} else if err := snowClient.CreateObject(client.EndpointCatalogUIPolicyAction, action); err != nil {
+			return err
+		}
+	}
+
+	for _, action := range existingByVariable {
+		if err := snowClient.DeleteObject(client.EndpointCatalogUIPolicyAction, action.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFromCatalogUIPolicy(data *schema.ResourceData, catalogUIPolicy *client.CatalogUIPolicy, actions []client.CatalogUIPolicyAction) {
+	actionList := make([]interface{}, 0, len(actions))
+	for _, action := range actions {
+		actionList = append(actionList, map[string]interface{}{
+			catalogUIPolicyActionVariableID: catalogVariableIDFromReference(action.Variable),
+			catalogUIPolicyActionVisible:    action.Visible,
+			catalogUIPolicyActionMandatory:  action.Mandatory,
+			catalogUIPolicyActionReadOnly:   action.ReadOnly,
+		})
+	}
+
+	data.SetId(catalogUIPolicy.ID)
+	data.Set(catalogUIPolicyShortDescription, catalogUIPolicy.ShortDescription)
+	data.Set(catalogUIPolicyCatalogItemID, catalogUIPolicy.CatalogItemID)
+	data.Set(catalogUIPolicyConditions, catalogUIPolicy.Conditions)
+	data.Set(catalogUIPolicyOrder, catalogUIPolicy.Order)
+	data.Set(catalogUIPolicyOnLoad, catalogUIPolicy.OnLoad)
+	data.Set(catalogUIPolicyReverseIfFalse, catalogUIPolicy.ReverseIfFalse)
+	data.Set(catalogUIPolicyActive, catalogUIPolicy.Active)
+	data.Set(catalogUIPolicyAppliesCatalogItem, catalogUIPolicy.AppliesCatalogItem)
+	data.Set(catalogUIPolicyAppliesRequestedItem, catalogUIPolicy.AppliesRequestedItem)
+	data.Set(catalogUIPolicyAppliesCatalogTask, catalogUIPolicy.AppliesCatalogTask)
+	data.Set(catalogUIPolicyRunScripts, catalogUIPolicy.RunScripts)
+	data.Set(catalogUIPolicyScriptTrue, catalogUIPolicy.ScriptTrue)
+	data.Set(catalogUIPolicyScriptFalse, catalogUIPolicy.ScriptFalse)
+	data.Set(catalogUIPolicyAction, actionList)
+	data.Set(commonScope, catalogUIPolicy.Scope)
+}
+
+func resourceToCatalogUIPolicy(data *schema.ResourceData) *client.CatalogUIPolicy {
+	catalogUIPolicy := client.CatalogUIPolicy{
+		ShortDescription:     data.Get(catalogUIPolicyShortDescription).(string),
+		CatalogItemID:        data.Get(catalogUIPolicyCatalogItemID).(string),
+		Conditions:           data.Get(catalogUIPolicyConditions).(string),
+		Order:                data.Get(catalogUIPolicyOrder).(int),
+		OnLoad:               data.Get(catalogUIPolicyOnLoad).(bool),
+		ReverseIfFalse:       data.Get(catalogUIPolicyReverseIfFalse).(bool),
+		Active:               data.Get(catalogUIPolicyActive).(bool),
+		AppliesCatalogItem:   data.Get(catalogUIPolicyAppliesCatalogItem).(bool),
+		AppliesRequestedItem: data.Get(catalogUIPolicyAppliesRequestedItem).(bool),
+		AppliesCatalogTask:   data.Get(catalogUIPolicyAppliesCatalogTask).(bool),
+		RunScripts:           data.Get(catalogUIPolicyRunScripts).(bool),
+		ScriptTrue:           data.Get(catalogUIPolicyScriptTrue).(string),
+		ScriptFalse:          data.Get(catalogUIPolicyScriptFalse).(string),
+	}
+	catalogUIPolicy.ID = data.Id()
+	catalogUIPolicy.Scope = data.Get(commonScope).(string)
+	return &catalogUIPolicy
+}
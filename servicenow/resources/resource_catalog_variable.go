@@ -0,0 +1,321 @@
+package resources
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const catalogVariableCatalogItemID = "catalog_item_id"
+const catalogVariableName = "name"
+const catalogVariableQuestion = "question"
+const catalogVariableType = "type"
+const catalogVariableOrder = "order"
+const catalogVariableMandatory = "mandatory"
+const catalogVariableReferenceTable = "reference_table"
+const catalogVariableDefaultValue = "default_value"
+const catalogVariableActive = "active"
+const catalogVariableTargetField = "target_field"
+const catalogVariableChoice = "choice"
+const catalogVariableChoiceValue = "value"
+const catalogVariableChoiceText = "text"
+const catalogVariableChoiceOrder = "order"
+
+// catalogVariableTypes maps the names accepted in the configuration to the type codes used by ServiceNow.
+var catalogVariableTypes = map[string]string{
+	"yes_no":            "1",
+	"multi_line_text":   "2",
+	"multiple_choice":   "3",
+	"numeric_scale":     "4",
+	"select_box":        "5",
+	"single_line_text":  "6",
+	"checkbox":          "7",
+	"reference":         "8",
+	"date":              "9",
+	"date_time":         "10",
+	"label":             "11",
+	"lookup_select_box": "18",
+	"list_collector":    "21",
+	"html":              "23",
+	"masked":            "25",
+	"email":             "26",
+	"url":               "27",
+	"duration":          "29",
+	"requested_for":     "31",
+	"attachment":        "33",
+}
+
+// ResourceCatalogVariable is holding the info about a variable (question) of a Service Catalog Item.
+// Choices of the variable that are not declared are removed.
+func ResourceCatalogVariable() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceCatalogVariable,
+		Read:   readResourceCatalogVariable,
+		Update: updateResourceCatalogVariable,
+		Delete: deleteResourceCatalogVariable,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			catalogVariableCatalogItemID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the catalog item asking this variable.",
+			},
+			catalogVariableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the variable, used in scripts.",
+			},
+			catalogVariableQuestion: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The question displayed to the user.",
+			},
+			catalogVariableType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "single_line_text",
+				Description: "The type of the variable, ex: 'single_line_text', 'select_box', 'reference', 'checkbox'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					names := make([]string, 0, len(catalogVariableTypes))
+					for name := range catalogVariableTypes {
+						names = append(names, name)
+					}
+					sort.Strings(names)
+					warns, errs = validateStringValue(val.(string), key, names)
+					return
+				},
+			},
+			catalogVariableOrder: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+			catalogVariableMandatory: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			catalogVariableReferenceTable: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is set to 'reference'. The name of the referenced table.",
+			},
+			catalogVariableDefaultValue: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			catalogVariableActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			catalogVariableTargetField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used by record producers. The column of the target table receiving the answer of the variable.",
+			},
+			catalogVariableChoice: {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The options of a choice variable, such as 'select_box' or 'multiple_choice'.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						catalogVariableChoiceValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						catalogVariableChoiceText: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The text displayed to users for this choice.",
+						},
+						catalogVariableChoiceOrder: {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  0,
+						},
+					},
+				},
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceCatalogVariable(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogVariable := &client.CatalogVariable{}
+	if err := snowClient.GetObject(client.EndpointCatalogVariable, data.Id(), catalogVariable); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	choices := []client.CatalogVariableChoice{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogVariableChoice, "question="+data.Id(), &choices); err != nil {
+		return err
+	}
+
+	resourceFromCatalogVariable(data, catalogVariable, choices)
+
+	return nil
+}
+
+func createResourceCatalogVariable(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	catalogVariable := resourceToCatalogVariable(data)
+	if err := snowClient.CreateObject(client.EndpointCatalogVariable, catalogVariable); err != nil {
+		return err
+	}
+
+	data.SetId(catalogVariable.ID)
+
+	if err := syncCatalogVariableChoices(data, snowClient); err != nil {
+		return err
+	}
+
+	return readResourceCatalogVariable(data, serviceNowClient)
+}
+
+func updateResourceCatalogVariable(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointCatalogVariable, resourceToCatalogVariable(data)); err != nil {
+		return err
+	}
+
+	if data.HasChange(catalogVariableChoice) {
+		if err := syncCatalogVariableChoices(data, snowClient); err != nil {
+			return err
+		}
+	}
+
+	return readResourceCatalogVariable(data, serviceNowClient)
+}
+
+func deleteResourceCatalogVariable(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	choices := []client.CatalogVariableChoice{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogVariableChoice, "question="+data.Id(), &choices); err != nil {
+		return err
+	}
+
+	for _, choice := range choices {
+		if err := snowClient.DeleteObject(client.EndpointCatalogVariableChoice, choice.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointCatalogVariable, data.Id())
+}
+
+// catalogVariableReference builds the value used by catalog client scripts and UI policies to reference a variable.
+func catalogVariableReference(variableID string) string {
+	if variableID == "" {
+		return ""
+	}
+	return "IO:" + variableID
+}
+
+// catalogVariableIDFromReference extracts the ID of a variable from a catalog variable reference.
+func catalogVariableIDFromReference(reference string) string {
+	return strings.TrimPrefix(reference, "IO:")
+}
+
+// syncCatalogVariableChoices updates the existing choices matching a declared value, creates the missing
+// ones and deletes the ones that are not declared anymore.
+func syncCatalogVariableChoices(data *schema.ResourceData, snowClient client.ServiceNowClient) error {
+	existingChoices := []client.CatalogVariableChoice{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointCatalogVariableChoice, "question="+data.Id(), &existingChoices); err != nil {
+		return err
+	}
+
+	existingByValue := map[string]client.CatalogVariableChoice{}
+	for _, choice := range existingChoices {
+		existingByValue[choice.Value] = choice
+	}
+
+	for _, item := range data.Get(catalogVariableChoice).(*schema.Set).List() {
+		values := item.(map[string]interface{})
+		choice := &client.CatalogVariableChoice{
+			VariableID: data.Id(),
+			Value:      values[catalogVariableChoiceValue].(string),
+			Text:       values[catalogVariableChoiceText].(string),
+			Order:      values[catalogVariableChoiceOrder].(int),
+		}
+		choice.Scope = data.Get(commonScope).(string)
+		if existing, ok := existingByValue[choice.Value]; ok {
+			choice.ID = existing.ID
+			if err := snowClient.UpdateObject(client.EndpointCatalogVariableChoice, choice); err != nil {
+				return err
+			}
+			delete(existingByValue, choice.Value)
+		} else if err := snowClient.CreateObject(client.EndpointCatalogVariableChoice, choice); err != nil {
+			return err
+		}
+	}
+
+	for _, choice := range existingByValue {
+		if err := snowClient.DeleteObject(client.EndpointCatalogVariableChoice, choice.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFromCatalogVariable(data *schema.ResourceData, catalogVariable *client.CatalogVariable, choices []client.CatalogVariableChoice) {
+	variableType := catalogVariable.Type
+	for name, code := range catalogVariableTypes {
+		if code == catalogVariable.Type {
+			variableType = name
+		}
+	}
+
+	choiceList := make([]interface{}, 0, len(choices))
+	for _, choice := range choices {
+		choiceList = append(choiceList, map[string]interface{}{
+			catalogVariableChoiceValue: choice.Value,
+			catalogVariableChoiceText:  choice.Text,
+			catalogVariableChoiceOrder: choice.Order,
+		})
+	}
+
+	data.SetId(catalogVariable.ID)
+	data.Set(catalogVariableCatalogItemID, catalogVariable.CatalogItemID)
+	data.Set(catalogVariableName, catalogVariable.Name)
+	data.Set(catalogVariableQuestion, catalogVariable.Question)
+	data.Set(catalogVariableType, variableType)
+	data.Set(catalogVariableOrder, catalogVariable.Order)
+	data.Set(catalogVariableMandatory, catalogVariable.Mandatory)
+	data.Set(catalogVariableReferenceTable, catalogVariable.ReferenceTable)
+	data.Set(catalogVariableDefaultValue, catalogVariable.DefaultValue)
+	data.Set(catalogVariableActive, catalogVariable.Active)
+	data.Set(catalogVariableTargetField, catalogVariable.Field)
+	data.Set(catalogVariableChoice, choiceList)
+	data.Set(commonScope, catalogVariable.Scope)
+}
+
+func resourceToCatalogVariable(data *schema.ResourceData) *client.CatalogVariable {
+	catalogVariable := client.CatalogVariable{
+		CatalogItemID:  data.Get(catalogVariableCatalogItemID).(string),
+		Name:           data.Get(catalogVariableName).(string),
+		Question:       data.Get(catalogVariableQuestion).(string),
+		Type:           catalogVariableTypes[data.Get(catalogVariableType).(string)],
+		Order:          data.Get(catalogVariableOrder).(int),
+		Mandatory:      data.Get(catalogVariableMandatory).(bool),
+		ReferenceTable: data.Get(catalogVariableReferenceTable).(string),
+		DefaultValue:   data.Get(catalogVariableDefaultValue).(string),
+		Active:         data.Get(catalogVariableActive).(bool),
+		Field:          data.Get(catalogVariableTargetField).(string),
+	}
+	catalogVariable.MapToField = catalogVariable.Field != ""
+	catalogVariable.ID = data.Id()
+	catalogVariable.Scope = data.Get(commonScope).(string)
+	return &catalogVariable
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const choiceTableName = "table_name"
+const choiceElement = "element"
+const choiceValue = "value"
+const choiceLabel = "label"
+const choiceSequence = "sequence"
+const choiceLanguage = "language"
+const choiceInactive = "inactive"
+const choiceDependentValue = "dependent_value"
+
+// ResourceChoice manages a single option of a choice list in ServiceNow.
+func ResourceChoice() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceChoice,
+		Read:   readResourceChoice,
+		Update: updateResourceChoice,
+		Delete: deleteResourceChoice,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			choiceTableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the table holding the choice column.",
+			},
+			choiceElement: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the choice column.",
+			},
+			choiceValue: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value stored in the database when this choice is selected.",
+			},
+			choiceLabel: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The text displayed to users for this choice.",
+			},
+			choiceSequence: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The display order of the choice in the list.",
+			},
+			choiceLanguage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "en",
+				Description: "The language of the label.",
+			},
+			choiceInactive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Hides the choice from the list while keeping existing values valid.",
+			},
+			choiceDependentValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Only show this choice when the dependent field of the column has this value.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceChoice(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	choice := &client.Choice{}
+	if err := snowClient.GetObject(client.EndpointChoice, data.Id(), choice); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromChoice(data, choice)
+
+	return nil
+}
+
+func createResourceChoice(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	choice := resourceToChoice(data)
+	if err := snowClient.CreateObject(client.EndpointChoice, choice); err != nil {
+		return err
+	}
+
+	resourceFromChoice(data, choice)
+
+	return readResourceChoice(data, serviceNowClient)
+}
+
+func updateResourceChoice(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointChoice, resourceToChoice(data)); err != nil {
+		return err
+	}
+
+	return readResourceChoice(data, serviceNowClient)
+}
+
+func deleteResourceChoice(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointChoice, data.Id())
+}
+
+func resourceFromChoice(data *schema.ResourceData, choice *client.Choice) {
+	data.SetId(choice.ID)
+	data.Set(choiceTableName, choice.TableName)
+	data.Set(choiceElement, choice.Element)
+	data.Set(choiceValue, choice.Value)
+	data.Set(choiceLabel, choice.Label)
+	data.Set(choiceSequence, choice.Sequence)
+	data.Set(choiceLanguage, choice.Language)
+	data.Set(choiceInactive, choice.Inactive)
+	data.Set(choiceDependentValue, choice.DependentValue)
+	data.Set(commonScope, choice.Scope)
+}
+
+func resourceToChoice(data *schema.ResourceData) *client.Choice {
+	choice := client.Choice{
+		TableName:      data.Get(choiceTableName).(string),
+		Element:        data.Get(choiceElement).(string),
+		Value:          data.Get(choiceValue).(string),
+		Label:          data.Get(choiceLabel).(string),
+		Sequence:       data.Get(choiceSequence).(int),
+		Language:       data.Get(choiceLanguage).(string),
+		Inactive:       data.Get(choiceInactive).(bool),
+		DependentValue: data.Get(choiceDependentValue).(string),
+	}
+	choice.ID = data.Id()
+	choice.Scope = data.Get(commonScope).(string)
+	return &choice
+}
@@ -0,0 +1,237 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const choiceSetTableName = "table_name"
+const choiceSetElement = "element"
+const choiceSetLanguage = "language"
+const choiceSetChoice = "choice"
+
+// ResourceChoiceSet manages all the options of a choice list in ServiceNow. Choices of the element
+// that are not declared in the set are removed, so the whole list is authoritative.
+func ResourceChoiceSet() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceChoiceSet,
+		Read:   readResourceChoiceSet,
+		Update: updateResourceChoiceSet,
+		Delete: deleteResourceChoiceSet,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			choiceSetTableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the table holding the choice column.",
+			},
+			choiceSetElement: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the choice column.",
+			},
+			choiceSetLanguage: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "en",
+				ForceNew:    true,
+				Description: "The language of the labels. Only choices in this language are managed.",
+			},
+			choiceSetChoice: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The options of the choice list.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						choiceValue: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value stored in the database when this choice is selected.",
+						},
+						choiceLabel: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The text displayed to users for this choice.",
+						},
+						choiceSequence: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "The display order of the choice in the list.",
+						},
+						choiceInactive: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Hides the choice from the list while keeping existing values valid.",
+						},
+						choiceDependentValue: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "Only show this choice when the dependent field of the column has this value.",
+						},
+					},
+				},
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceChoiceSet(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	choices := []client.Choice{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointChoice, choiceSetQuery(data), &choices); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromChoiceSet(data, choices)
+
+	return nil
+}
+
+func createResourceChoiceSet(data *schema.ResourceData, serviceNowClient interface{}) error {
+	if err := syncChoiceSet(data, serviceNowClient.(client.ServiceNowClient)); err != nil {
+		return err
+	}
+
+	data.SetId(data.Get(choiceSetTableName).(string) + "." + data.Get(choiceSetElement).(string))
+
+	return readResourceChoiceSet(data, serviceNowClient)
+}
+
+func updateResourceChoiceSet(data *schema.ResourceData, serviceNowClient interface{}) error {
+	if err := syncChoiceSet(data, serviceNowClient.(client.ServiceNowClient)); err != nil {
+		return err
+	}
+
+	return readResourceChoiceSet(data, serviceNowClient)
+}
+
+func deleteResourceChoiceSet(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	choices := []client.Choice{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointChoice, choiceSetQuery(data), &choices); err != nil {
+		return err
+	}
+
+	for _, choice := range choices {
+		if err := snowClient.DeleteObject(client.EndpointChoice, choice.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// choiceSetKey identifies a choice of the set. Dependent choices may share a value, so the
+// dependent value is part of the key.
+type choiceSetKey struct {
+	value          string
+	dependentValue string
+}
+
+// syncChoiceSet updates the existing choices matching a declared value, creates the missing ones and
+// deletes the ones that are not declared anymore, including duplicates.
+func syncChoiceSet(data *schema.ResourceData, snowClient client.ServiceNowClient) error {
+	existingChoices := []client.Choice{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointChoice, choiceSetQuery(data), &existingChoices); err != nil {
+		return err
+	}
+
+	existingByKey := map[choiceSetKey][]client.Choice{}
+	for _, choice := range existingChoices {
+		key := choiceSetKey{choice.Value, choice.DependentValue}
+		existingByKey[key] = append(existingByKey[key], choice)
+	}
+
+	for _, choice := range resourceToChoiceSet(data) {
+		key := choiceSetKey{choice.Value, choice.DependentValue}
+		if existing := existingByKey[key]; len(existing) > 0 {
+			choice.ID = existing[0].ID
+			if err := snowClient.UpdateObject(client.EndpointChoice, choice); err != nil {
+				return err
+			}
+			existingByKey[key] = existing[1:]
+		} else if err := snowClient.CreateObject(client.EndpointChoice, choice); err != nil {
+			return err
+		}
+	}
+
+	for _, choices := range existingByKey {
+		for _, choice := range choices {
+			if err := snowClient.DeleteObject(client.EndpointChoice, choice.ID); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// choiceSetQuery builds the query matching all the choices of the set. When importing, the table
+// and element are only known through the ID, in the format 'table.element'.
+func choiceSetQuery(data *schema.ResourceData) string {
+	tableName := data.Get(choiceSetTableName).(string)
+	element := data.Get(choiceSetElement).(string)
+	if tableName == "" && element == "" {
+		if parts := strings.SplitN(data.Id(), ".", 2); len(parts) == 2 {
+			tableName, element = parts[0], parts[1]
+		}
+	}
+	language := data.Get(choiceSetLanguage).(string)
+	if language == "" {
+		language = "en"
+	}
+	return fmt.Sprintf("name=%s^element=%s^language=%s", tableName, element, language)
+}
+
+func resourceFromChoiceSet(data *schema.ResourceData, choices []client.Choice) {
+	choiceList := make([]interface{}, 0, len(choices))
+	for _, choice := range choices {
+		choiceList = append(choiceList, map[string]interface{}{
+			choiceValue:          choice.Value,
+			choiceLabel:          choice.Label,
+			choiceSequence:       choice.Sequence,
+			choiceInactive:       choice.Inactive,
+			choiceDependentValue: choice.DependentValue,
+		})
+	}
+	if len(choices) > 0 {
+		data.Set(choiceSetTableName, choices[0].TableName)
+		data.Set(choiceSetElement, choices[0].Element)
+		data.Set(choiceSetLanguage, choices[0].Language)
+		data.Set(commonScope, choices[0].Scope)
+	}
+	data.Set(choiceSetChoice, choiceList)
+}
+
+func resourceToChoiceSet(data *schema.ResourceData) []*client.Choice {
+	choiceList := data.Get(choiceSetChoice).(*schema.Set).List()
+	choices := make([]*client.Choice, 0, len(choiceList))
+	for _, item := range choiceList {
+		values := item.(map[string]interface{})
+		choice := client.Choice{
+			TableName:      data.Get(choiceSetTableName).(string),
+			Element:        data.Get(choiceSetElement).(string),
+			Language:       data.Get(choiceSetLanguage).(string),
+			Value:          values[choiceValue].(string),
+			Label:          values[choiceLabel].(string),
+			Sequence:       values[choiceSequence].(int),
+			Inactive:       values[choiceInactive].(bool),
+			DependentValue: values[choiceDependentValue].(string),
+		}
+		choice.Scope = data.Get(commonScope).(string)
+		choices = append(choices, &choice)
+	}
+	return choices
+}
@@ -0,0 +1,258 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const dataSourceName = "name"
+const dataSourceType = "type"
+const dataSourceFormat = "format"
+const dataSourceImportSetTableName = "import_set_table_name"
+const dataSourceImportSetTableLabel = "import_set_table_label"
+const dataSourceFileRetrievalMethod = "file_retrieval_method"
+const dataSourceFilePath = "file_path"
+const dataSourceHeaderRow = "header_row"
+const dataSourceXPathRootNode = "xpath_root_node"
+const dataSourceServer = "server"
+const dataSourcePort = "port"
+const dataSourceUserName = "username"
+const dataSourcePassword = "password"
+const dataSourceDatabaseName = "database_name"
+const dataSourceTableName = "table_name"
+const dataSourceSQLStatement = "sql_statement"
+const dataSourceLDAPTargetID = "ldap_target_id"
+const dataSourceMidServerID = "mid_server_id"
+
+// ResourceDataSource is holding the info about the source of the rows loaded in an import set table.
+func ResourceDataSource() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceDataSource,
+		Read:   readResourceDataSource,
+		Update: updateResourceDataSource,
+		Delete: deleteResourceDataSource,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			dataSourceName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			dataSourceType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "File",
+				Description: "Where the data comes from. Can be 'File', 'JDBC', 'LDAP', 'REST (IntegrationHub)' or 'Custom (Load by Script)'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"File", "JDBC", "LDAP", "REST (IntegrationHub)", "Custom (Load by Script)"})
+					return
+				},
+			},
+			dataSourceFormat: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "For 'File', the format of the file, ex: 'CSV', 'Excel', 'XML' or 'JSON'. For 'JDBC', the type of database, ex: 'MySQL' or 'SQLServer'.",
+			},
+			dataSourceImportSetTableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the import set table receiving the rows. It is created on the first load.",
+			},
+			dataSourceImportSetTableLabel: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			dataSourceFileRetrievalMethod: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "Attachment",
+				Description: "Used when 'type' is 'File'. How the file is obtained, ex: 'Attachment', 'HTTPS', 'SFTP' or 'FTP'.",
+			},
+			dataSourceFilePath: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is 'File'. The path or URL of the file on the server.",
+			},
+			dataSourceHeaderRow: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     1,
+				Description: "Used for 'CSV' and 'Excel' files. The row holding the column names.",
+			},
+			dataSourceXPathRootNode: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used for 'XML' files. The XPath of the nodes imported as rows.",
+			},
+			dataSourceServer: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The host of the file server or the database.",
+			},
+			dataSourcePort: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  0,
+			},
+			dataSourceUserName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The user name used to connect to the file server or the database.",
+			},
+			dataSourcePassword: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The password used to connect to the file server or the database.",
+			},
+			dataSourceDatabaseName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is 'JDBC'.",
+			},
+			dataSourceTableName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is 'JDBC'. The database table to import. Ignored when 'sql_statement' is set.",
+			},
+			dataSourceSQLStatement: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is 'JDBC'. The query returning the rows to import.",
+			},
+			dataSourceLDAPTargetID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Used when 'type' is 'LDAP'. The ID of the LDAP OU definition to import.",
+			},
+			dataSourceMidServerID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the MID server connecting to the source, when it is not reachable from the instance.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceDataSource(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dataSource := &client.DataSource{}
+	if err := snowClient.GetObject(client.EndpointDataSource, data.Id(), dataSource); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromDataSource(data, dataSource)
+
+	return nil
+}
+
+func createResourceDataSource(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dataSource := resourceToDataSource(data)
+	if err := snowClient.CreateObject(client.EndpointDataSource, dataSource); err != nil {
+		return err
+	}
+
+	resourceFromDataSource(data, dataSource)
+
+	return readResourceDataSource(data, serviceNowClient)
+}
+
+func updateResourceDataSource(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointDataSource, resourceToDataSource(data)); err != nil {
+		return err
+	}
+
+	return readResourceDataSource(data, serviceNowClient)
+}
+
+func deleteResourceDataSource(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointDataSource, data.Id())
+}
+
+func resourceFromDataSource(data *schema.ResourceData, dataSource *client.DataSource) {
+	data.SetId(dataSource.ID)
+	data.Set(dataSourceName, dataSource.Name)
+	data.Set(dataSourceType, dataSource.Type)
+	data.Set(dataSourceFormat, dataSource.Format)
+	data.Set(dataSourceImportSetTableName, dataSource.ImportSetTableName)
+	data.Set(dataSourceImportSetTableLabel, dataSource.ImportSetTableLabel)
+	data.Set(dataSourceFileRetrievalMethod, dataSource.FileRetrievalMethod)
+	data.Set(dataSourceFilePath, dataSource.FilePath)
+	data.Set(dataSourceHeaderRow, atoiOrZero(dataSource.HeaderRow))
+	data.Set(dataSourceXPathRootNode, dataSource.XPathRootNode)
+	// JDBC sources store their connection in dedicated columns.
+	if dataSource.Type == "JDBC" {
+		data.Set(dataSourceServer, dataSource.JDBCServer)
+		data.Set(dataSourcePort, atoiOrZero(dataSource.JDBCPort))
+		data.Set(dataSourceUserName, dataSource.JDBCUserName)
+	} else {
+		data.Set(dataSourceServer, dataSource.Server)
+		data.Set(dataSourcePort, atoiOrZero(dataSource.Port))
+		data.Set(dataSourceUserName, dataSource.UserName)
+	}
+	data.Set(dataSourceDatabaseName, dataSource.DatabaseName)
+	data.Set(dataSourceTableName, dataSource.TableName)
+	data.Set(dataSourceSQLStatement, dataSource.SQLStatement)
+	data.Set(dataSourceLDAPTargetID, dataSource.LDAPTargetID)
+	data.Set(dataSourceMidServerID, dataSource.MidServerID)
+	data.Set(commonScope, dataSource.Scope)
+}
+
+func resourceToDataSource(data *schema.ResourceData) *client.DataSource {
+	dataSource := client.DataSource{
+		Name:                data.Get(dataSourceName).(string),
+		Type:                data.Get(dataSourceType).(string),
+		Format:              data.Get(dataSourceFormat).(string),
+		ImportSetTableName:  data.Get(dataSourceImportSetTableName).(string),
+		ImportSetTableLabel: data.Get(dataSourceImportSetTableLabel).(string),
+		FileRetrievalMethod: data.Get(dataSourceFileRetrievalMethod).(string),
+		FilePath:            data.Get(dataSourceFilePath).(string),
+		HeaderRow:           itoaOrEmpty(data.Get(dataSourceHeaderRow).(int)),
+		XPathRootNode:       data.Get(dataSourceXPathRootNode).(string),
+		DatabaseName:        data.Get(dataSourceDatabaseName).(string),
+		TableName:           data.Get(dataSourceTableName).(string),
+		SQLStatement:        data.Get(dataSourceSQLStatement).(string),
+		LDAPTargetID:        data.Get(dataSourceLDAPTargetID).(string),
+		MidServerID:         data.Get(dataSourceMidServerID).(string),
+	}
+
+	// JDBC and other types store the password in different columns, so it is sent again when the type changes.
+	password := getWriteOnlyValue(data, dataSourcePassword)
+	if data.HasChange(dataSourceType) {
+		password = data.Get(dataSourcePassword).(string)
+	}
+	if dataSource.Type == "JDBC" {
+		dataSource.JDBCServer = data.Get(dataSourceServer).(string)
+		dataSource.JDBCPort = itoaOrEmpty(data.Get(dataSourcePort).(int))
+		dataSource.JDBCUserName = data.Get(dataSourceUserName).(string)
+		dataSource.JDBCPassword = password
+	} else {
+		dataSource.Server = data.Get(dataSourceServer).(string)
+		dataSource.Port = itoaOrEmpty(data.Get(dataSourcePort).(int))
+		dataSource.UserName = data.Get(dataSourceUserName).(string)
+		dataSource.Password = password
+	}
+
+	dataSource.ID = data.Id()
+	dataSource.Scope = data.Get(commonScope).(string)
+	return &dataSource
+}
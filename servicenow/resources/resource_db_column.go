@@ -0,0 +1,202 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const dbColumnTableName = "table_name"
+const dbColumnElement = "element"
+const dbColumnLabel = "label"
+const dbColumnType = "type"
+const dbColumnMaxLength = "max_length"
+const dbColumnReferenceTable = "reference_table"
+const dbColumnDefaultValue = "default_value"
+const dbColumnMandatory = "mandatory"
+const dbColumnReadOnly = "read_only"
+const dbColumnUnique = "unique"
+const dbColumnDisplay = "display"
+const dbColumnAttributes = "attributes"
+const dbColumnDependentField = "dependent_field"
+const dbColumnActive = "active"
+
+// ResourceDBColumn manages a column (dictionary entry) of a DBTable in ServiceNow.
+func ResourceDBColumn() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceDBColumn,
+		Read:   readResourceDBColumn,
+		Update: updateResourceDBColumn,
+		Delete: deleteResourceDBColumn,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			dbColumnTableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the table this column belongs to.",
+			},
+			dbColumnElement: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the column. Columns of scoped tables must start with 'u_' or the scope prefix.",
+			},
+			dbColumnLabel: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name for this column that can be localized.",
+			},
+			dbColumnType: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal type of the column, such as 'string', 'integer', 'boolean', 'reference', 'glide_date_time' or 'choice'.",
+			},
+			dbColumnMaxLength: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     40,
+				Description: "Maximum number of characters the column can hold.",
+			},
+			dbColumnReferenceTable: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the table referenced by this column when the type is 'reference'.",
+			},
+			dbColumnDefaultValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Value given to the column when a new record is created. Can be a script in the format 'javascript:...'.",
+			},
+			dbColumnMandatory: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether a value is required for this column when saving a record.",
+			},
+			dbColumnReadOnly: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the column is read-only in forms.",
+			},
+			dbColumnUnique: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the values of this column must be unique across the table.",
+			},
+			dbColumnDisplay: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this column is the display value of the table when referenced by other records.",
+			},
+			dbColumnAttributes: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of dictionary attributes, in the format 'name=value'.",
+			},
+			dbColumnDependentField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of another column of the table whose value filters the choices of this column.",
+			},
+			dbColumnActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this column is enabled.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceDBColumn(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dbColumn := &client.DBColumn{}
+	if err := snowClient.GetObject(client.EndpointDBColumn, data.Id(), dbColumn); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromDBColumn(data, dbColumn)
+
+	return nil
+}
+
+func createResourceDBColumn(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dbColumn := resourceToDBColumn(data)
+	if err := snowClient.CreateObject(client.EndpointDBColumn, dbColumn); err != nil {
+		return err
+	}
+
+	resourceFromDBColumn(data, dbColumn)
+
+	return readResourceDBColumn(data, serviceNowClient)
+}
+
+func updateResourceDBColumn(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointDBColumn, resourceToDBColumn(data)); err != nil {
+		return err
+	}
+
+	return readResourceDBColumn(data, serviceNowClient)
+}
+
+func deleteResourceDBColumn(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointDBColumn, data.Id())
+}
+
+func resourceFromDBColumn(data *schema.ResourceData, dbColumn *client.DBColumn) {
+	data.SetId(dbColumn.ID)
+	data.Set(dbColumnTableName, dbColumn.TableName)
+	data.Set(dbColumnElement, dbColumn.Element)
+	data.Set(dbColumnLabel, dbColumn.Label)
+	data.Set(dbColumnType, dbColumn.Type)
+	data.Set(dbColumnMaxLength, dbColumn.MaxLength)
+	data.Set(dbColumnReferenceTable, dbColumn.ReferenceTable)
+	data.Set(dbColumnDefaultValue, dbColumn.DefaultValue)
+	data.Set(dbColumnMandatory, dbColumn.Mandatory)
+	data.Set(dbColumnReadOnly, dbColumn.ReadOnly)
+	data.Set(dbColumnUnique, dbColumn.Unique)
+	data.Set(dbColumnDisplay, dbColumn.Display)
+	data.Set(dbColumnAttributes, dbColumn.Attributes)
+	data.Set(dbColumnDependentField, dbColumn.DependentField)
+	data.Set(dbColumnActive, dbColumn.Active)
+	data.Set(commonScope, dbColumn.Scope)
+}
+
+func resourceToDBColumn(data *schema.ResourceData) *client.DBColumn {
+	dbColumn := client.DBColumn{
+		TableName:      data.Get(dbColumnTableName).(string),
+		Element:        data.Get(dbColumnElement).(string),
+		Label:          data.Get(dbColumnLabel).(string),
+		Type:           data.Get(dbColumnType).(string),
+		MaxLength:      data.Get(dbColumnMaxLength).(int),
+		ReferenceTable: data.Get(dbColumnReferenceTable).(string),
+		DefaultValue:   data.Get(dbColumnDefaultValue).(string),
+		Mandatory:      data.Get(dbColumnMandatory).(bool),
+		ReadOnly:       data.Get(dbColumnReadOnly).(bool),
+		Unique:         data.Get(dbColumnUnique).(bool),
+		Display:        data.Get(dbColumnDisplay).(bool),
+		Attributes:     data.Get(dbColumnAttributes).(string),
+		DependentField: data.Get(dbColumnDependentField).(string),
+		Active:         data.Get(dbColumnActive).(bool),
+	}
+	dbColumn.ID = data.Id()
+	dbColumn.Scope = data.Get(commonScope).(string)
+	return &dbColumn
+}
@@ -17,6 +17,11 @@ const dbTableConfigurationAccess = "configuration_access"
 const dbTableExtendable = "extendable"
 const dbTableLiveFeed = "live_feed"
 const dbTableName = "name"
+const dbTableExtends = "extends"
+const dbTableAutoNumber = "auto_number"
+const dbTableAutoNumberPrefix = "prefix"
+const dbTableAutoNumberDigits = "digits"
+const dbTableAutoNumberStartingNumber = "starting_number"
 
 // ResourceDBTable manages a DBTable in ServiceNow.
 func ResourceDBTable() *schema.Resource {
@@ -104,6 +109,40 @@ func ResourceDBTable() *schema.Resource {
 				Computed:    true,
 				Description: "The internal name of the table.",
 			},
+			dbTableExtends: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				ForceNew:    true,
+				Description: "The internal name of the parent table this table extends, such as 'task' or 'cmdb_ci'. The parent table must be extendable.",
+			},
+			dbTableAutoNumber: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Automatically numbers new records of the table, for example 'XAPP0001000'.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dbTableAutoNumberPrefix: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Prefix added in front of every number.",
+						},
+						dbTableAutoNumberDigits: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     7,
+							Description: "Minimum number of digits in the number, padded with zeros.",
+						},
+						dbTableAutoNumberStartingNumber: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     1000,
+							Description: "Number given to the next record created.",
+						},
+					},
+				},
+			},
 			commonScope: getScopeSchema(),
 		},
 	}
@@ -117,20 +156,34 @@ func readResourceDBTable(data *schema.ResourceData, serviceNowClient interface{}
 		return err
 	}
 
-	resourceFromDBTable(data, dbTable)
-
-	return nil
+	return resourceFromDBTableWithReferences(data, dbTable, snowClient)
 }
 
 func createResourceDBTable(data *schema.ResourceData, serviceNowClient interface{}) error {
 	snowClient := serviceNowClient.(client.ServiceNowClient)
 	dbTable := resourceToDBTable(data)
+
+	// The parent table is referenced by its sys_id, so it must be resolved from its name.
+	if extends := data.Get(dbTableExtends).(string); extends != "" {
+		parentTable := &client.DBTable{}
+		if err := snowClient.GetObjectByName(client.EndpointDBTable, extends, parentTable); err != nil {
+			return err
+		}
+		dbTable.SuperClass = parentTable.ID
+	}
+
 	if err := snowClient.CreateObject(client.EndpointDBTable, dbTable); err != nil {
 		return err
 	}
 
 	resourceFromDBTable(data, dbTable)
 
+	if _, ok := data.GetOk(dbTableAutoNumber); ok {
+		if err := syncDBTableAutoNumber(data, snowClient); err != nil {
+			return err
+		}
+	}
+
 	return readResourceDBTable(data, serviceNowClient)
 }
 
@@ -140,6 +193,12 @@ func updateResourceDBTable(data *schema.ResourceData, serviceNowClient interface
 		return err
 	}
 
+	if data.HasChange(dbTableAutoNumber) {
+		if err := syncDBTableAutoNumber(data, snowClient); err != nil {
+			return err
+		}
+	}
+
 	return readResourceDBTable(data, serviceNowClient)
 }
 
@@ -165,6 +224,85 @@ func resourceFromDBTable(data *schema.ResourceData, dbTable *client.DBTable) {
 	data.Set(commonScope, dbTable.Scope)
 }
 
+// resourceFromDBTableWithReferences fills the data from the table and resolves its parent table and
+// auto-number records.
+func resourceFromDBTableWithReferences(data *schema.ResourceData, dbTable *client.DBTable, snowClient client.ServiceNowClient) error {
+	resourceFromDBTable(data, dbTable)
+
+	extends := ""
+	if dbTable.SuperClass != "" {
+		parentTable := &client.DBTable{}
+		if err := snowClient.GetObject(client.EndpointDBTable, dbTable.SuperClass, parentTable); err != nil {
+			return err
+		}
+		extends = parentTable.Name
+	}
+	data.Set(dbTableExtends, extends)
+
+	autoNumber := []interface{}{}
+	if dbTable.NumberRef != "" {
+		// The number record may have been deleted while still referenced, which is read as no auto-number.
+		numbers := []client.Number{}
+		if err := snowClient.GetObjectsByQuery(client.EndpointNumber, "sys_id="+dbTable.NumberRef, &numbers); err != nil {
+			return err
+		}
+		for _, number := range numbers {
+			autoNumber = append(autoNumber, map[string]interface{}{
+				dbTableAutoNumberPrefix:         number.Prefix,
+				dbTableAutoNumberDigits:         number.Digits,
+				dbTableAutoNumberStartingNumber: number.Number,
+			})
+		}
+	}
+	data.Set(dbTableAutoNumber, autoNumber)
+
+	return nil
+}
+
+// syncDBTableAutoNumber creates, updates or deletes the auto-number record of the table. When the
+// block is removed, the reference on the table is cleared before the sys_number record is deleted.
+func syncDBTableAutoNumber(data *schema.ResourceData, snowClient client.ServiceNowClient) error {
+	dbTable := &client.DBTable{}
+	if err := snowClient.GetObject(client.EndpointDBTable, data.Id(), dbTable); err != nil {
+		return err
+	}
+
+	autoNumber := data.Get(dbTableAutoNumber).([]interface{})
+	if len(autoNumber) == 0 || autoNumber[0] == nil {
+		if dbTable.NumberRef == "" {
+			return nil
+		}
+		tableWithoutNumber := &client.DBTableNumberRef{}
+		tableWithoutNumber.ID = data.Id()
+		if err := snowClient.UpdateObject(client.EndpointDBTable, tableWithoutNumber); err != nil {
+			return err
+		}
+		return snowClient.DeleteObject(client.EndpointNumber, dbTable.NumberRef)
+	}
+
+	values := autoNumber[0].(map[string]interface{})
+	number := &client.Number{
+		Category: dbTable.Name,
+		Prefix:   values[dbTableAutoNumberPrefix].(string),
+		Digits:   values[dbTableAutoNumberDigits].(int),
+		Number:   values[dbTableAutoNumberStartingNumber].(int),
+	}
+	number.Scope = data.Get(commonScope).(string)
+
+	if dbTable.NumberRef != "" {
+		number.ID = dbTable.NumberRef
+		return snowClient.UpdateObject(client.EndpointNumber, number)
+	}
+
+	if err := snowClient.CreateObject(client.EndpointNumber, number); err != nil {
+		return err
+	}
+
+	tableWithNumber := resourceToDBTable(data)
+	tableWithNumber.NumberRef = number.ID
+	return snowClient.UpdateObject(client.EndpointDBTable, tableWithNumber)
+}
+
 func resourceToDBTable(data *schema.ResourceData) *client.DBTable {
 	dbTable := client.DBTable{
 		Label:                data.Get(dbTableLabel).(string),
@@ -0,0 +1,236 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const dbViewName = "name"
+const dbViewLabel = "label"
+const dbViewDescription = "description"
+const dbViewTable = "table"
+const dbViewTableName = "table_name"
+const dbViewTableVariablePrefix = "variable_prefix"
+const dbViewTableOrder = "order"
+const dbViewTableWhereClause = "where_clause"
+const dbViewTableLeftJoin = "left_join"
+
+// ResourceDBView manages a Database View in ServiceNow, joining multiple tables for reporting.
+func ResourceDBView() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceDBView,
+		Read:   readResourceDBView,
+		Update: updateResourceDBView,
+		Delete: deleteResourceDBView,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			dbViewName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the view. Must start with 'u_' or the scope prefix.",
+			},
+			dbViewLabel: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name for this view that can be localized.",
+			},
+			dbViewDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Describe the purpose of the view.",
+			},
+			dbViewTable: {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The tables joined in the view. Tables are identified by their variable prefix.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						dbViewTableName: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The internal name of the table to join.",
+						},
+						dbViewTableVariablePrefix: {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Short prefix added to the column names of this table in the view. Must be unique within the view.",
+						},
+						dbViewTableOrder: {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     100,
+							Description: "The order in which the table is joined. The table with the lowest order is the base of the view.",
+						},
+						dbViewTableWhereClause: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "SQL-like condition joining this table to the previous ones, using the variable prefixes. For example 'inc_caller_id = usr_sys_id'.",
+						},
+						dbViewTableLeftJoin: {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether records of the previous tables are kept when no record of this table matches.",
+						},
+					},
+				},
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceDBView(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dbView := &client.DBView{}
+	if err := snowClient.GetObject(client.EndpointDBView, data.Id(), dbView); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	viewTables := []client.DBViewTable{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointDBViewTable, "view="+data.Id()+"^ORDERBYorder", &viewTables); err != nil {
+		return err
+	}
+
+	resourceFromDBView(data, dbView, viewTables)
+
+	return nil
+}
+
+func createResourceDBView(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dbView := resourceToDBView(data)
+	if err := snowClient.CreateObject(client.EndpointDBView, dbView); err != nil {
+		return err
+	}
+
+	data.SetId(dbView.ID)
+
+	if err := syncDBViewTables(data, snowClient); err != nil {
+		return err
+	}
+
+	return readResourceDBView(data, serviceNowClient)
+}
+
+func updateResourceDBView(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointDBView, resourceToDBView(data)); err != nil {
+		return err
+	}
+
+	if data.HasChange(dbViewTable) {
+		if err := syncDBViewTables(data, snowClient); err != nil {
+			return err
+		}
+	}
+
+	return readResourceDBView(data, serviceNowClient)
+}
+
+func deleteResourceDBView(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	viewTables := []client.DBViewTable{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointDBViewTable, "view="+data.Id(), &viewTables); err != nil {
+		return err
+	}
+
+	for _, viewTable := range viewTables {
+		if err := snowClient.DeleteObject(client.EndpointDBViewTable, viewTable.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointDBView, data.Id())
+}
+
+// syncDBViewTables updates the joined tables matching a declared variable prefix, creates the missing
+// ones and deletes the ones that are not declared anymore.
+func syncDBViewTables(data *schema.ResourceData, snowClient client.ServiceNowClient) error {
+	existingTables := []client.DBViewTable{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointDBViewTable, "view="+data.Id(), &existingTables); err != nil {
+		return err
+	}
+
+	existingByPrefix := map[string]client.DBViewTable{}
+	for _, viewTable := range existingTables {
+		existingByPrefix[viewTable.VariablePrefix] = viewTable
+	}
+
+	for _, viewTable := range resourceToDBViewTables(data) {
+		if existing, ok := existingByPrefix[viewTable.VariablePrefix]; ok {
+			viewTable.ID = existing.ID
+			if err := snowClient.UpdateObject(client.EndpointDBViewTable, viewTable); err != nil {
+				return err
+			}
+			delete(existingByPrefix, viewTable.VariablePrefix)
+		} else if err := snowClient.CreateObject(client.EndpointDBViewTable, viewTable); err != nil {
+			return err
+		}
+	}
+
+	for _, viewTable := range existingByPrefix {
+		if err := snowClient.DeleteObject(client.EndpointDBViewTable, viewTable.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFromDBView(data *schema.ResourceData, dbView *client.DBView, viewTables []client.DBViewTable) {
+	data.SetId(dbView.ID)
+	data.Set(dbViewName, dbView.Name)
+	data.Set(dbViewLabel, dbView.Label)
+	data.Set(dbViewDescription, dbView.Description)
+	data.Set(commonScope, dbView.Scope)
+
+	tableList := make([]interface{}, 0, len(viewTables))
+	for _, viewTable := range viewTables {
+		tableList = append(tableList, map[string]interface{}{
+			dbViewTableName:           viewTable.TableName,
+			dbViewTableVariablePrefix: viewTable.VariablePrefix,
+			dbViewTableOrder:          viewTable.Order,
+			dbViewTableWhereClause:    viewTable.WhereClause,
+			dbViewTableLeftJoin:       viewTable.LeftJoin,
+		})
+	}
+	data.Set(dbViewTable, tableList)
+}
+
+func resourceToDBView(data *schema.ResourceData) *client.DBView {
+	dbView := client.DBView{
+		Name:        data.Get(dbViewName).(string),
+		Label:       data.Get(dbViewLabel).(string),
+		Description: data.Get(dbViewDescription).(string),
+	}
+	dbView.ID = data.Id()
+	dbView.Scope = data.Get(commonScope).(string)
+	return &dbView
+}
+
+func resourceToDBViewTables(data *schema.ResourceData) []*client.DBViewTable {
+	tableList := data.Get(dbViewTable).([]interface{})
+	viewTables := make([]*client.DBViewTable, 0, len(tableList))
+	for _, item := range tableList {
+		values := item.(map[string]interface{})
+		viewTable := client.DBViewTable{
+			ViewID:         data.Id(),
+			TableName:      values[dbViewTableName].(string),
+			VariablePrefix: values[dbViewTableVariablePrefix].(string),
+			Order:          values[dbViewTableOrder].(int),
+			WhereClause:    values[dbViewTableWhereClause].(string),
+			LeftJoin:       values[dbViewTableLeftJoin].(bool),
+		}
+		viewTable.Scope = data.Get(commonScope).(string)
+		viewTables = append(viewTables, &viewTable)
+	}
+	return viewTables
+}
@@ -0,0 +1,214 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const dictionaryOverrideTableName = "table_name"
+const dictionaryOverrideBaseTable = "base_table"
+const dictionaryOverrideElement = "element"
+const dictionaryOverrideDefaultValueOverride = "default_value_override"
+const dictionaryOverrideDefaultValue = "default_value"
+const dictionaryOverrideMandatoryOverride = "mandatory_override"
+const dictionaryOverrideMandatory = "mandatory"
+const dictionaryOverrideReadOnlyOverride = "read_only_override"
+const dictionaryOverrideReadOnly = "read_only"
+const dictionaryOverrideReferenceQualifierOverride = "reference_qualifier_override"
+const dictionaryOverrideReferenceQualifier = "reference_qualifier"
+const dictionaryOverrideCalculationOverride = "calculation_override"
+const dictionaryOverrideCalculation = "calculation"
+const dictionaryOverrideAttributesOverride = "attributes_override"
+const dictionaryOverrideAttributes = "attributes"
+
+// ResourceDictionaryOverride manages a Dictionary Override in ServiceNow, changing the behavior of an
+// inherited column for a single child table.
+func ResourceDictionaryOverride() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceDictionaryOverride,
+		Read:   readResourceDictionaryOverride,
+		Update: updateResourceDictionaryOverride,
+		Delete: deleteResourceDictionaryOverride,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			dictionaryOverrideTableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the child table where the override applies.",
+			},
+			dictionaryOverrideElement: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The internal name of the inherited column to override.",
+			},
+			dictionaryOverrideBaseTable: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The internal name of the parent table where the column is defined. Resolved by the instance when not set.",
+			},
+			dictionaryOverrideDefaultValueOverride: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the default value is overridden.",
+			},
+			dictionaryOverrideDefaultValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Default value of the column for the child table. Used when default_value_override is set.",
+			},
+			dictionaryOverrideMandatoryOverride: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the mandatory flag is overridden.",
+			},
+			dictionaryOverrideMandatory: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the column is mandatory for the child table. Used when mandatory_override is set.",
+			},
+			dictionaryOverrideReadOnlyOverride: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the read-only flag is overridden.",
+			},
+			dictionaryOverrideReadOnly: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the column is read-only for the child table. Used when read_only_override is set.",
+			},
+			dictionaryOverrideReferenceQualifierOverride: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the reference qualifier is overridden.",
+			},
+			dictionaryOverrideReferenceQualifier: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Reference qualifier of the column for the child table. Used when reference_qualifier_override is set.",
+			},
+			dictionaryOverrideCalculationOverride: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the calculated value is overridden.",
+			},
+			dictionaryOverrideCalculation: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script calculating the value of the column for the child table. Used when calculation_override is set.",
+			},
+			dictionaryOverrideAttributesOverride: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the dictionary attributes are overridden.",
+			},
+			dictionaryOverrideAttributes: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of dictionary attributes for the child table, in the format 'name=value'. Used when attributes_override is set.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceDictionaryOverride(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dictionaryOverride := &client.DictionaryOverride{}
+	if err := snowClient.GetObject(client.EndpointDictionaryOverride, data.Id(), dictionaryOverride); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromDictionaryOverride(data, dictionaryOverride)
+
+	return nil
+}
+
+func createResourceDictionaryOverride(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	dictionaryOverride := resourceToDictionaryOverride(data)
+	if err := snowClient.CreateObject(client.EndpointDictionaryOverride, dictionaryOverride); err != nil {
+		return err
+	}
+
+	resourceFromDictionaryOverride(data, dictionaryOverride)
+
+	return readResourceDictionaryOverride(data, serviceNowClient)
+}
+
+func updateResourceDictionaryOverride(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointDictionaryOverride, resourceToDictionaryOverride(data)); err != nil {
+		return err
+	}
+
+	return readResourceDictionaryOverride(data, serviceNowClient)
+}
+
+func deleteResourceDictionaryOverride(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointDictionaryOverride, data.Id())
+}
+
+func resourceFromDictionaryOverride(data *schema.ResourceData, dictionaryOverride *client.DictionaryOverride) {
+	data.SetId(dictionaryOverride.ID)
+	data.Set(dictionaryOverrideTableName, dictionaryOverride.TableName)
+	data.Set(dictionaryOverrideBaseTable, dictionaryOverride.BaseTable)
+	data.Set(dictionaryOverrideElement, dictionaryOverride.Element)
+	data.Set(dictionaryOverrideDefaultValueOverride, dictionaryOverride.DefaultValueOverride)
+	data.Set(dictionaryOverrideDefaultValue, dictionaryOverride.DefaultValue)
+	data.Set(dictionaryOverrideMandatoryOverride, dictionaryOverride.MandatoryOverride)
+	data.Set(dictionaryOverrideMandatory, dictionaryOverride.Mandatory)
+	data.Set(dictionaryOverrideReadOnlyOverride, dictionaryOverride.ReadOnlyOverride)
+	data.Set(dictionaryOverrideReadOnly, dictionaryOverride.ReadOnly)
+	data.Set(dictionaryOverrideReferenceQualifierOverride, dictionaryOverride.ReferenceQualifierOverride)
+	data.Set(dictionaryOverrideReferenceQualifier, dictionaryOverride.ReferenceQualifier)
+	data.Set(dictionaryOverrideCalculationOverride, dictionaryOverride.CalculationOverride)
+	data.Set(dictionaryOverrideCalculation, dictionaryOverride.Calculation)
+	data.Set(dictionaryOverrideAttributesOverride, dictionaryOverride.AttributesOverride)
+	data.Set(dictionaryOverrideAttributes, dictionaryOverride.Attributes)
+	data.Set(commonScope, dictionaryOverride.Scope)
+}
+
+func resourceToDictionaryOverride(data *schema.ResourceData) *client.DictionaryOverride {
+	dictionaryOverride := client.DictionaryOverride{
+		TableName:                  data.Get(dictionaryOverrideTableName).(string),
+		BaseTable:                  data.Get(dictionaryOverrideBaseTable).(string),
+		Element:                    data.Get(dictionaryOverrideElement).(string),
+		DefaultValueOverride:       data.Get(dictionaryOverrideDefaultValueOverride).(bool),
+		DefaultValue:               data.Get(dictionaryOverrideDefaultValue).(string),
+		MandatoryOverride:          data.Get(dictionaryOverrideMandatoryOverride).(bool),
+		Mandatory:                  data.Get(dictionaryOverrideMandatory).(bool),
+		ReadOnlyOverride:           data.Get(dictionaryOverrideReadOnlyOverride).(bool),
+		ReadOnly:                   data.Get(dictionaryOverrideReadOnly).(bool),
+		ReferenceQualifierOverride: data.Get(dictionaryOverrideReferenceQualifierOverride).(bool),
+		ReferenceQualifier:         data.Get(dictionaryOverrideReferenceQualifier).(string),
+		CalculationOverride:        data.Get(dictionaryOverrideCalculationOverride).(bool),
+		Calculation:                data.Get(dictionaryOverrideCalculation).(string),
+		AttributesOverride:         data.Get(dictionaryOverrideAttributesOverride).(bool),
+		Attributes:                 data.Get(dictionaryOverrideAttributes).(string),
+	}
+	dictionaryOverride.ID = data.Id()
+	dictionaryOverride.Scope = data.Get(commonScope).(string)
+	return &dictionaryOverride
+}
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const emailScriptName = "name"
+const emailScriptScript = "script"
+const emailScriptNewLinesToHTML = "new_lines_to_html"
+
+// ResourceEmailScript manages a Notification Email Script in ServiceNow, included in emails with '${mail_script:name}'.
+func ResourceEmailScript() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceEmailScript,
+		Read:   readResourceEmailScript,
+		Update: updateResourceEmailScript,
+		Delete: deleteResourceEmailScript,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			emailScriptName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the script, used to include it in notifications and templates.",
+			},
+			emailScriptScript: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Javascript script printing content in the email. The variables 'current', 'template', 'email', 'email_action' and 'event' are available.",
+			},
+			emailScriptNewLinesToHTML: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether new lines printed by the script are converted to HTML line breaks.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceEmailScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	emailScript := &client.EmailScript{}
+	if err := snowClient.GetObject(client.EndpointEmailScript, data.Id(), emailScript); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromEmailScript(data, emailScript)
+
+	return nil
+}
+
+func createResourceEmailScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	emailScript := resourceToEmailScript(data)
+	if err := snowClient.CreateObject(client.EndpointEmailScript, emailScript); err != nil {
+		return err
+	}
+
+	resourceFromEmailScript(data, emailScript)
+
+	return readResourceEmailScript(data, serviceNowClient)
+}
+
+func updateResourceEmailScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointEmailScript, resourceToEmailScript(data)); err != nil {
+		return err
+	}
+
+	return readResourceEmailScript(data, serviceNowClient)
+}
+
+func deleteResourceEmailScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointEmailScript, data.Id())
+}
+
+func resourceFromEmailScript(data *schema.ResourceData, emailScript *client.EmailScript) {
+	data.SetId(emailScript.ID)
+	data.Set(emailScriptName, emailScript.Name)
+	data.Set(emailScriptScript, emailScript.Script)
+	data.Set(emailScriptNewLinesToHTML, emailScript.NewLinesToHTML)
+	data.Set(commonScope, emailScript.Scope)
+}
+
+func resourceToEmailScript(data *schema.ResourceData) *client.EmailScript {
+	emailScript := client.EmailScript{
+		Name:           data.Get(emailScriptName).(string),
+		Script:         data.Get(emailScriptScript).(string),
+		NewLinesToHTML: data.Get(emailScriptNewLinesToHTML).(bool),
+	}
+	emailScript.ID = data.Id()
+	emailScript.Scope = data.Get(commonScope).(string)
+	return &emailScript
+}
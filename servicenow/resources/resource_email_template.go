@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const emailTemplateName = "name"
+const emailTemplateTable = "table"
+const emailTemplateSubject = "subject"
+const emailTemplateMessageHTML = "message_html"
+
+// ResourceEmailTemplate manages an Email Template in ServiceNow, reusable by multiple notifications.
+func ResourceEmailTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceEmailTemplate,
+		Read:   readResourceEmailTemplate,
+		Update: updateResourceEmailTemplate,
+		Delete: deleteResourceEmailTemplate,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			emailTemplateName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the template.",
+			},
+			emailTemplateTable: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The internal name of the table the template can be used with. Can be used with all tables when empty.",
+			},
+			emailTemplateSubject: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Subject of the email. Can contain variables in the format '${column}'.",
+			},
+			emailTemplateMessageHTML: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "HTML body of the email. Can contain variables in the format '${column}' and email scripts in the format '${mail_script:name}'.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceEmailTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	emailTemplate := &client.EmailTemplate{}
+	if err := snowClient.GetObject(client.EndpointEmailTemplate, data.Id(), emailTemplate); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromEmailTemplate(data, emailTemplate)
+
+	return nil
+}
+
+func createResourceEmailTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	emailTemplate := resourceToEmailTemplate(data)
+	if err := snowClient.CreateObject(client.EndpointEmailTemplate, emailTemplate); err != nil {
+		return err
+	}
+
+	resourceFromEmailTemplate(data, emailTemplate)
+
+	return readResourceEmailTemplate(data, serviceNowClient)
+}
+
+func updateResourceEmailTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointEmailTemplate, resourceToEmailTemplate(data)); err != nil {
+		return err
+	}
+
+	return readResourceEmailTemplate(data, serviceNowClient)
+}
+
+func deleteResourceEmailTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointEmailTemplate, data.Id())
+}
+
+func resourceFromEmailTemplate(data *schema.ResourceData, emailTemplate *client.EmailTemplate) {
+	data.SetId(emailTemplate.ID)
+	data.Set(emailTemplateName, emailTemplate.Name)
+	data.Set(emailTemplateTable, emailTemplate.Table)
+	data.Set(emailTemplateSubject, emailTemplate.Subject)
+	data.Set(emailTemplateMessageHTML, emailTemplate.MessageHTML)
+	data.Set(commonScope, emailTemplate.Scope)
+}
+
+func resourceToEmailTemplate(data *schema.ResourceData) *client.EmailTemplate {
+	emailTemplate := client.EmailTemplate{
+		Name:        data.Get(emailTemplateName).(string),
+		Table:       data.Get(emailTemplateTable).(string),
+		Subject:     data.Get(emailTemplateSubject).(string),
+		MessageHTML: data.Get(emailTemplateMessageHTML).(string),
+	}
+	emailTemplate.ID = data.Id()
+	emailTemplate.Scope = data.Get(commonScope).(string)
+	return &emailTemplate
+}
@@ -0,0 +1,121 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const eventName = "name"
+const eventTable = "table"
+const eventFiredBy = "fired_by"
+const eventDescription = "description"
+const eventQueue = "queue"
+
+// ResourceEvent manages an Event Registration in ServiceNow, needed to fire events with gs.eventQueue.
+func ResourceEvent() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceEvent,
+		Read:   readResourceEvent,
+		Update: updateResourceEvent,
+		Delete: deleteResourceEvent,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			eventName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the event, used to fire it in scripts.",
+			},
+			eventTable: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The internal name of the table of the records passed with the event.",
+			},
+			eventFiredBy: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Describe which scripts or business rules fire the event.",
+			},
+			eventDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Describe the purpose of the event.",
+			},
+			eventQueue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of a dedicated queue processing the event. Uses the default queue when empty.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceEvent(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	event := &client.Event{}
+	if err := snowClient.GetObject(client.EndpointEvent, data.Id(), event); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromEvent(data, event)
+
+	return nil
+}
+
+func createResourceEvent(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	event := resourceToEvent(data)
+	if err := snowClient.CreateObject(client.EndpointEvent, event); err != nil {
+		return err
+	}
+
+	resourceFromEvent(data, event)
+
+	return readResourceEvent(data, serviceNowClient)
+}
+
+func updateResourceEvent(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointEvent, resourceToEvent(data)); err != nil {
+		return err
+	}
+
+	return readResourceEvent(data, serviceNowClient)
+}
+
+func deleteResourceEvent(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointEvent, data.Id())
+}
+
+func resourceFromEvent(data *schema.ResourceData, event *client.Event) {
+	data.SetId(event.ID)
+	data.Set(eventName, event.Name)
+	data.Set(eventTable, event.Table)
+	data.Set(eventFiredBy, event.FiredBy)
+	data.Set(eventDescription, event.Description)
+	data.Set(eventQueue, event.Queue)
+	data.Set(commonScope, event.Scope)
+}
+
+func resourceToEvent(data *schema.ResourceData) *client.Event {
+	event := client.Event{
+		Name:        data.Get(eventName).(string),
+		Table:       data.Get(eventTable).(string),
+		FiredBy:     data.Get(eventFiredBy).(string),
+		Description: data.Get(eventDescription).(string),
+		Queue:       data.Get(eventQueue).(string),
+	}
+	event.ID = data.Id()
+	event.Scope = data.Get(commonScope).(string)
+	return &event
+}
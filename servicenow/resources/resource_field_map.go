@@ -0,0 +1,143 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const fieldMapTransformMapID = "transform_map_id"
+const fieldMapSourceField = "source_field"
+const fieldMapTargetField = "target_field"
+const fieldMapCoalesce = "coalesce"
+const fieldMapChoiceAction = "choice_action"
+const fieldMapDateFormat = "date_format"
+const fieldMapScript = "script"
+
+// ResourceFieldMap is holding the info about the mapping of a column of an import set table to a column of the target table.
+func ResourceFieldMap() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceFieldMap,
+		Read:   readResourceFieldMap,
+		Update: updateResourceFieldMap,
+		Delete: deleteResourceFieldMap,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			fieldMapTransformMapID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the transform map containing the field map.",
+			},
+			fieldMapSourceField: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The column of the import set table. Can be empty when 'script' computes the value.",
+			},
+			fieldMapTargetField: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The column of the target table receiving the value.",
+			},
+			fieldMapCoalesce: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the column is used to find an existing record to update instead of inserting a new one.",
+			},
+			fieldMapChoiceAction: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "create",
+				Description: "What happens when the value does not match a choice or reference. Can be 'create', 'ignore' or 'reject'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"create", "ignore", "reject"})
+					return
+				},
+			},
+			fieldMapDateFormat: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The format used to parse date values, ex: 'yyyy-MM-dd'.",
+			},
+			fieldMapScript: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script returning the value to set, instead of copying the source field.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceFieldMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	fieldMap := &client.FieldMap{}
+	if err := snowClient.GetObject(client.EndpointFieldMap, data.Id(), fieldMap); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromFieldMap(data, fieldMap)
+
+	return nil
+}
+
+func createResourceFieldMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	fieldMap := resourceToFieldMap(data)
+	if err := snowClient.CreateObject(client.EndpointFieldMap, fieldMap); err != nil {
+		return err
+	}
+
+	resourceFromFieldMap(data, fieldMap)
+
+	return readResourceFieldMap(data, serviceNowClient)
+}
+
+func updateResourceFieldMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointFieldMap, resourceToFieldMap(data)); err != nil {
+		return err
+	}
+
+	return readResourceFieldMap(data, serviceNowClient)
+}
+
+func deleteResourceFieldMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointFieldMap, data.Id())
+}
+
+func resourceFromFieldMap(data *schema.ResourceData, fieldMap *client.FieldMap) {
+	data.SetId(fieldMap.ID)
+	data.Set(fieldMapTransformMapID, fieldMap.TransformMapID)
+	data.Set(fieldMapSourceField, fieldMap.SourceField)
+	data.Set(fieldMapTargetField, fieldMap.TargetField)
+	data.Set(fieldMapCoalesce, fieldMap.Coalesce)
+	data.Set(fieldMapChoiceAction, fieldMap.ChoiceAction)
+	data.Set(fieldMapDateFormat, fieldMap.DateFormat)
+	data.Set(fieldMapScript, fieldMap.SourceScript)
+	data.Set(commonScope, fieldMap.Scope)
+}
+
+func resourceToFieldMap(data *schema.ResourceData) *client.FieldMap {
+	fieldMap := client.FieldMap{
+		TransformMapID: data.Get(fieldMapTransformMapID).(string),
+		SourceField:    data.Get(fieldMapSourceField).(string),
+		TargetField:    data.Get(fieldMapTargetField).(string),
+		Coalesce:       data.Get(fieldMapCoalesce).(bool),
+		ChoiceAction:   data.Get(fieldMapChoiceAction).(string),
+		DateFormat:     data.Get(fieldMapDateFormat).(string),
+		SourceScript:   data.Get(fieldMapScript).(string),
+	}
+	fieldMap.UseSourceScript = fieldMap.SourceScript != ""
+	fieldMap.ID = data.Id()
+	fieldMap.Scope = data.Get(commonScope).(string)
+	return &fieldMap
+}
@@ -0,0 +1,127 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const groupName = "name"
+const groupDescription = "description"
+const groupEmail = "email"
+const groupManagerID = "manager_id"
+const groupParentID = "parent_id"
+const groupActive = "active"
+
+// ResourceGroup manages a User Group in ServiceNow.
+func ResourceGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceGroup,
+		Read:   readResourceGroup,
+		Update: updateResourceGroup,
+		Delete: deleteResourceGroup,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			groupName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique name of the group.",
+			},
+			groupDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Describe the purpose of the group.",
+			},
+			groupEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Email address used to notify the whole group.",
+			},
+			groupManagerID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The user record ID of the manager of this group.",
+			},
+			groupParentID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The group record ID of the parent group. Roles of the parent group are inherited.",
+			},
+			groupActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this group is enabled.",
+			},
+		},
+	}
+}
+
+func readResourceGroup(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	group := &client.Group{}
+	if err := snowClient.GetObject(client.EndpointGroup, data.Id(), group); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromGroup(data, group)
+
+	return nil
+}
+
+func createResourceGroup(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	group := resourceToGroup(data)
+	if err := snowClient.CreateObject(client.EndpointGroup, group); err != nil {
+		return err
+	}
+
+	resourceFromGroup(data, group)
+
+	return readResourceGroup(data, serviceNowClient)
+}
+
+func updateResourceGroup(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointGroup, resourceToGroup(data)); err != nil {
+		return err
+	}
+
+	return readResourceGroup(data, serviceNowClient)
+}
+
+func deleteResourceGroup(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointGroup, data.Id())
+}
+
+func resourceFromGroup(data *schema.ResourceData, group *client.Group) {
+	data.SetId(group.ID)
+	data.Set(groupName, group.Name)
+	data.Set(groupDescription, group.Description)
+	data.Set(groupEmail, group.Email)
+	data.Set(groupManagerID, group.ManagerID)
+	data.Set(groupParentID, group.ParentID)
+	data.Set(groupActive, group.Active)
+}
+
+func resourceToGroup(data *schema.ResourceData) *client.Group {
+	group := client.Group{
+		Name:        data.Get(groupName).(string),
+		Description: data.Get(groupDescription).(string),
+		Email:       data.Get(groupEmail).(string),
+		ManagerID:   data.Get(groupManagerID).(string),
+		ParentID:    data.Get(groupParentID).(string),
+		Active:      data.Get(groupActive).(bool),
+	}
+	group.ID = data.Id()
+	return &group
+}
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const groupMemberUserID = "user_id"
+const groupMemberGroupID = "group_id"
+
+// ResourceGroupMember is holding the membership of a user in a group (many-2-many).
+func ResourceGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceGroupMember,
+		Read:   readResourceGroupMember,
+		Update: updateResourceGroupMember,
+		Delete: deleteResourceGroupMember,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			groupMemberUserID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The user record ID to add to the group.",
+			},
+			groupMemberGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The group record ID the user is a member of.",
+			},
+		},
+	}
+}
+
+func readResourceGroupMember(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	groupMember := &client.GroupMember{}
+	if err := snowClient.GetObject(client.EndpointGroupMember, data.Id(), groupMember); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromGroupMember(data, groupMember)
+
+	return nil
+}
+
+func createResourceGroupMember(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	groupMember := resourceToGroupMember(data)
+	if err := snowClient.CreateObject(client.EndpointGroupMember, groupMember); err != nil {
+		return err
+	}
+
+	resourceFromGroupMember(data, groupMember)
+
+	return readResourceGroupMember(data, serviceNowClient)
+}
+
+func updateResourceGroupMember(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointGroupMember, resourceToGroupMember(data)); err != nil {
+		return err
+	}
+
+	return readResourceGroupMember(data, serviceNowClient)
+}
+
+func deleteResourceGroupMember(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointGroupMember, data.Id())
+}
+
+func resourceFromGroupMember(data *schema.ResourceData, groupMember *client.GroupMember) {
+	data.SetId(groupMember.ID)
+	data.Set(groupMemberUserID, groupMember.UserID)
+	data.Set(groupMemberGroupID, groupMember.GroupID)
+}
+
+func resourceToGroupMember(data *schema.ResourceData) *client.GroupMember {
+	groupMember := client.GroupMember{
+		UserID:  data.Get(groupMemberUserID).(string),
+		GroupID: data.Get(groupMemberGroupID).(string),
+	}
+	groupMember.ID = data.Id()
+	return &groupMember
+}
@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const groupRoleGroupID = "group_id"
+const groupRoleRoleID = "role_id"
+const groupRoleInherits = "inherits"
+
+// ResourceGroupRole is holding a role granted to all the members of a group (many-2-many).
+func ResourceGroupRole() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceGroupRole,
+		Read:   readResourceGroupRole,
+		Update: updateResourceGroupRole,
+		Delete: deleteResourceGroupRole,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			groupRoleGroupID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The group record ID receiving the role.",
+			},
+			groupRoleRoleID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The role record ID granted to the group.",
+			},
+			groupRoleInherits: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether child groups also receive this role.",
+			},
+		},
+	}
+}
+
+func readResourceGroupRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	groupRole := &client.GroupRole{}
+	if err := snowClient.GetObject(client.EndpointGroupRole, data.Id(), groupRole); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromGroupRole(data, groupRole)
+
+	return nil
+}
+
+func createResourceGroupRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	groupRole := resourceToGroupRole(data)
+	if err := snowClient.CreateObject(client.EndpointGroupRole, groupRole); err != nil {
+		return err
+	}
+
+	resourceFromGroupRole(data, groupRole)
+
+	return readResourceGroupRole(data, serviceNowClient)
+}
+
+func updateResourceGroupRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointGroupRole, resourceToGroupRole(data)); err != nil {
+		return err
+	}
+
+	return readResourceGroupRole(data, serviceNowClient)
+}
+
+func deleteResourceGroupRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointGroupRole, data.Id())
+}
+
+func resourceFromGroupRole(data *schema.ResourceData, groupRole *client.GroupRole) {
+	data.SetId(groupRole.ID)
+	data.Set(groupRoleGroupID, groupRole.GroupID)
+	data.Set(groupRoleRoleID, groupRole.RoleID)
+	data.Set(groupRoleInherits, groupRole.Inherits)
+}
+
+func resourceToGroupRole(data *schema.ResourceData) *client.GroupRole {
+	groupRole := client.GroupRole{
+		GroupID:  data.Get(groupRoleGroupID).(string),
+		RoleID:   data.Get(groupRoleRoleID).(string),
+		Inherits: data.Get(groupRoleInherits).(bool),
+	}
+	groupRole.ID = data.Id()
+	return &groupRole
+}
@@ -0,0 +1,185 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const inboundEmailActionName = "name"
+const inboundEmailActionTable = "table"
+const inboundEmailActionAction = "action"
+const inboundEmailActionType = "type"
+const inboundEmailActionCondition = "condition"
+const inboundEmailActionStopProcessing = "stop_processing"
+const inboundEmailActionOrder = "order"
+const inboundEmailActionRequiredRoles = "required_roles"
+const inboundEmailActionScript = "script"
+const inboundEmailActionReplyEmail = "reply_email"
+const inboundEmailActionActive = "active"
+
+// ResourceInboundEmailAction manages an Inbound Email Action in ServiceNow.
+func ResourceInboundEmailAction() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceInboundEmailAction,
+		Read:   readResourceInboundEmailAction,
+		Update: updateResourceInboundEmailAction,
+		Delete: deleteResourceInboundEmailAction,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			inboundEmailActionName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the inbound email action.",
+			},
+			inboundEmailActionTable: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the table of the records created or updated by the action.",
+			},
+			inboundEmailActionAction: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "record_action",
+				Description: "What the action does with the email. Can be 'record_action' to run the script on a record or 'reply_email' to send a reply.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"record_action", "reply_email"})
+					return
+				},
+			},
+			inboundEmailActionType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "new",
+				Description: "The kind of email processed by the action. Can be 'new', 'reply' or 'forward'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"new", "reply", "forward"})
+					return
+				},
+			},
+			inboundEmailActionCondition: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Encoded query the email must match for the action to run.",
+			},
+			inboundEmailActionStopProcessing: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the inbound email actions with a higher order are skipped after this one runs.",
+			},
+			inboundEmailActionOrder: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The order in which the inbound email actions are evaluated.",
+			},
+			inboundEmailActionRequiredRoles: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of Roles (names) the sender must have for the action to run.",
+			},
+			inboundEmailActionScript: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The Javascript script to run when action is 'record_action'. The variables 'current', 'email', 'email.origemail' and 'logger' are available.",
+			},
+			inboundEmailActionReplyEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "HTML body of the reply sent when action is 'reply_email'.",
+			},
+			inboundEmailActionActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this inbound email action is enabled.",
+			},
+			commonProtectionPolicy: getProtectionPolicySchema(),
+			commonScope:            getScopeSchema(),
+		},
+	}
+}
+
+func readResourceInboundEmailAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	inboundEmailAction := &client.InboundEmailAction{}
+	if err := snowClient.GetObject(client.EndpointInboundEmailAction, data.Id(), inboundEmailAction); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromInboundEmailAction(data, inboundEmailAction)
+
+	return nil
+}
+
+func createResourceInboundEmailAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	inboundEmailAction := resourceToInboundEmailAction(data)
+	if err := snowClient.CreateObject(client.EndpointInboundEmailAction, inboundEmailAction); err != nil {
+		return err
+	}
+
+	resourceFromInboundEmailAction(data, inboundEmailAction)
+
+	return readResourceInboundEmailAction(data, serviceNowClient)
+}
+
+func updateResourceInboundEmailAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointInboundEmailAction, resourceToInboundEmailAction(data)); err != nil {
+		return err
+	}
+
+	return readResourceInboundEmailAction(data, serviceNowClient)
+}
+
+func deleteResourceInboundEmailAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointInboundEmailAction, data.Id())
+}
+
+func resourceFromInboundEmailAction(data *schema.ResourceData, inboundEmailAction *client.InboundEmailAction) {
+	data.SetId(inboundEmailAction.ID)
+	data.Set(inboundEmailActionName, inboundEmailAction.Name)
+	data.Set(inboundEmailActionTable, inboundEmailAction.Table)
+	data.Set(inboundEmailActionAction, inboundEmailAction.Action)
+	data.Set(inboundEmailActionType, inboundEmailAction.Type)
+	data.Set(inboundEmailActionCondition, inboundEmailAction.Condition)
+	data.Set(inboundEmailActionStopProcessing, inboundEmailAction.StopProcessing)
+	data.Set(inboundEmailActionOrder, inboundEmailAction.Order)
+	data.Set(inboundEmailActionRequiredRoles, inboundEmailAction.RequiredRoles)
+	data.Set(inboundEmailActionScript, inboundEmailAction.Script)
+	data.Set(inboundEmailActionReplyEmail, inboundEmailAction.ReplyEmail)
+	data.Set(inboundEmailActionActive, inboundEmailAction.Active)
+	data.Set(commonProtectionPolicy, inboundEmailAction.ProtectionPolicy)
+	data.Set(commonScope, inboundEmailAction.Scope)
+}
+
+func resourceToInboundEmailAction(data *schema.ResourceData) *client.InboundEmailAction {
+	inboundEmailAction := client.InboundEmailAction{
+		Name:           data.Get(inboundEmailActionName).(string),
+		Table:          data.Get(inboundEmailActionTable).(string),
+		Action:         data.Get(inboundEmailActionAction).(string),
+		Type:           data.Get(inboundEmailActionType).(string),
+		Condition:      data.Get(inboundEmailActionCondition).(string),
+		StopProcessing: data.Get(inboundEmailActionStopProcessing).(bool),
+		Order:          data.Get(inboundEmailActionOrder).(int),
+		RequiredRoles:  data.Get(inboundEmailActionRequiredRoles).(string),
+		Script:         data.Get(inboundEmailActionScript).(string),
+		ReplyEmail:     data.Get(inboundEmailActionReplyEmail).(string),
+		Active:         data.Get(inboundEmailActionActive).(bool),
+	}
+	inboundEmailAction.ID = data.Id()
+	inboundEmailAction.ProtectionPolicy = data.Get(commonProtectionPolicy).(string)
+	inboundEmailAction.Scope = data.Get(commonScope).(string)
+	return &inboundEmailAction
+}
@@ -0,0 +1,138 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const jwtKeystoreAliasName = "name"
+const jwtKeystoreAliasKeystoreID = "keystore_id"
+const jwtKeystoreAliasSigningAlgorithm = "signing_algorithm"
+const jwtKeystoreAliasSigningKeyAlias = "signing_key_alias"
+const jwtKeystoreAliasSigningKeyPassword = "signing_key_password"
+const jwtKeystoreAliasKeyID = "key_id"
+const jwtKeystoreAliasActive = "active"
+
+// ResourceJWTKeystoreAlias is holding the key used by JWT providers to sign tokens.
+func ResourceJWTKeystoreAlias() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceJWTKeystoreAlias,
+		Read:   readResourceJWTKeystoreAlias,
+		Update: updateResourceJWTKeystoreAlias,
+		Delete: deleteResourceJWTKeystoreAlias,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			jwtKeystoreAliasName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			jwtKeystoreAliasKeystoreID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the Java key store certificate holding the signing key.",
+			},
+			jwtKeystoreAliasSigningAlgorithm: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "RS256",
+				Description: "Can be 'RS256', 'RS384', 'RS512', 'HS256', 'HS384' or 'HS512'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"RS256", "RS384", "RS512", "HS256", "HS384", "HS512"})
+					return
+				},
+			},
+			jwtKeystoreAliasSigningKeyAlias: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The alias of the signing key in the key store.",
+			},
+			jwtKeystoreAliasSigningKeyPassword: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The password of the signing key in the key store.",
+			},
+			jwtKeystoreAliasKeyID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The 'kid' header of the generated tokens.",
+			},
+			jwtKeystoreAliasActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceJWTKeystoreAlias(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	jwtKeystoreAlias := &client.JWTKeystoreAlias{}
+	if err := snowClient.GetObject(client.EndpointJWTKeystoreAlias, data.Id(), jwtKeystoreAlias); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromJWTKeystoreAlias(data, jwtKeystoreAlias)
+
+	return nil
+}
+
+func createResourceJWTKeystoreAlias(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	jwtKeystoreAlias := resourceToJWTKeystoreAlias(data)
+	if err := snowClient.CreateObject(client.EndpointJWTKeystoreAlias, jwtKeystoreAlias); err != nil {
+		return err
+	}
+
+	resourceFromJWTKeystoreAlias(data, jwtKeystoreAlias)
+
+	return readResourceJWTKeystoreAlias(data, serviceNowClient)
+}
+
+func updateResourceJWTKeystoreAlias(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointJWTKeystoreAlias, resourceToJWTKeystoreAlias(data)); err != nil {
+		return err
+	}
+
+	return readResourceJWTKeystoreAlias(data, serviceNowClient)
+}
+
+func deleteResourceJWTKeystoreAlias(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointJWTKeystoreAlias, data.Id())
+}
+
+func resourceFromJWTKeystoreAlias(data *schema.ResourceData, jwtKeystoreAlias *client.JWTKeystoreAlias) {
+	data.SetId(jwtKeystoreAlias.ID)
+	data.Set(jwtKeystoreAliasName, jwtKeystoreAlias.Name)
+	data.Set(jwtKeystoreAliasKeystoreID, jwtKeystoreAlias.KeystoreID)
+	data.Set(jwtKeystoreAliasSigningAlgorithm, jwtKeystoreAlias.SigningAlgorithm)
+	data.Set(jwtKeystoreAliasSigningKeyAlias, jwtKeystoreAlias.SigningKeyAlias)
+	data.Set(jwtKeystoreAliasKeyID, jwtKeystoreAlias.KeyID)
+	data.Set(jwtKeystoreAliasActive, jwtKeystoreAlias.Active)
+	data.Set(commonScope, jwtKeystoreAlias.Scope)
+}
+
+func resourceToJWTKeystoreAlias(data *schema.ResourceData) *client.JWTKeystoreAlias {
+	jwtKeystoreAlias := client.JWTKeystoreAlias{
+		Name:             data.Get(jwtKeystoreAliasName).(string),
+		KeystoreID:       data.Get(jwtKeystoreAliasKeystoreID).(string),
+		SigningAlgorithm: data.Get(jwtKeystoreAliasSigningAlgorithm).(string),
+		SigningKeyAlias:  data.Get(jwtKeystoreAliasSigningKeyAlias).(string),
+		KeyID:            data.Get(jwtKeystoreAliasKeyID).(string),
+		Active:           data.Get(jwtKeystoreAliasActive).(bool),
+	}
+	jwtKeystoreAlias.SigningKeyPassword = getWriteOnlyValue(data, jwtKeystoreAliasSigningKeyPassword)
+	jwtKeystoreAlias.ID = data.Id()
+	jwtKeystoreAlias.Scope = data.Get(commonScope).(string)
+	return &jwtKeystoreAlias
+}
@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const jwtProviderName = "name"
+const jwtProviderExpiryInterval = "expiry_interval"
+const jwtProviderKeystoreAliasID = "keystore_alias_id"
+const jwtProviderActive = "active"
+
+// ResourceJWTProvider is holding the configuration used to generate JWTs for the OAuth JWT bearer grant.
+func ResourceJWTProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceJWTProvider,
+		Read:   readResourceJWTProvider,
+		Update: updateResourceJWTProvider,
+		Delete: deleteResourceJWTProvider,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			jwtProviderName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			jwtProviderExpiryInterval: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     3600,
+				Description: "Number of seconds a generated JWT is good for.",
+			},
+			jwtProviderKeystoreAliasID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the JWT keystore alias used to sign the tokens.",
+			},
+			jwtProviderActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceJWTProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	jwtProvider := &client.JWTProvider{}
+	if err := snowClient.GetObject(client.EndpointJWTProvider, data.Id(), jwtProvider); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromJWTProvider(data, jwtProvider)
+
+	return nil
+}
+
+func createResourceJWTProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	jwtProvider := resourceToJWTProvider(data)
+	if err := snowClient.CreateObject(client.EndpointJWTProvider, jwtProvider); err != nil {
+		return err
+	}
+
+	resourceFromJWTProvider(data, jwtProvider)
+
+	return readResourceJWTProvider(data, serviceNowClient)
+}
+
+func updateResourceJWTProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointJWTProvider, resourceToJWTProvider(data)); err != nil {
+		return err
+	}
+
+	return readResourceJWTProvider(data, serviceNowClient)
+}
+
+func deleteResourceJWTProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointJWTProvider, data.Id())
+}
+
+func resourceFromJWTProvider(data *schema.ResourceData, jwtProvider *client.JWTProvider) {
+	data.SetId(jwtProvider.ID)
+	data.Set(jwtProviderName, jwtProvider.Name)
+	data.Set(jwtProviderExpiryInterval, jwtProvider.ExpiryInterval)
+	data.Set(jwtProviderKeystoreAliasID, jwtProvider.KeystoreAliasID)
+	data.Set(jwtProviderActive, jwtProvider.Active)
+	data.Set(commonScope, jwtProvider.Scope)
+}
+
+func resourceToJWTProvider(data *schema.ResourceData) *client.JWTProvider {
+	jwtProvider := client.JWTProvider{
+		Name:            data.Get(jwtProviderName).(string),
+		ExpiryInterval:  data.Get(jwtProviderExpiryInterval).(int),
+		KeystoreAliasID: data.Get(jwtProviderKeystoreAliasID).(string),
+		Active:          data.Get(jwtProviderActive).(bool),
+	}
+	jwtProvider.ID = data.Id()
+	jwtProvider.Scope = data.Get(commonScope).(string)
+	return &jwtProvider
+}
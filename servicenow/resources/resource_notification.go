@@ -0,0 +1,240 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const notificationName = "name"
+const notificationTable = "table"
+const notificationSendWhen = "send_when"
+const notificationInserted = "inserted"
+const notificationUpdated = "updated"
+const notificationEventName = "event_name"
+const notificationCondition = "condition"
+const notificationRecipientUsers = "recipient_users"
+const notificationRecipientGroups = "recipient_groups"
+const notificationRecipientFields = "recipient_fields"
+const notificationSubject = "subject"
+const notificationMessageHTML = "message_html"
+const notificationTemplateID = "template_id"
+const notificationWeight = "weight"
+const notificationActive = "active"
+
+// ResourceNotification manages an Email Notification in ServiceNow.
+func ResourceNotification() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceNotification,
+		Read:   readResourceNotification,
+		Update: updateResourceNotification,
+		Delete: deleteResourceNotification,
+
+		CustomizeDiff: validateNotificationSendWhen,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			notificationName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the notification.",
+			},
+			notificationTable: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the table of the records the notification is about.",
+			},
+			notificationSendWhen: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "engine",
+				Description: "When the notification is sent. Can be 'engine' when a record is inserted or updated, 'event' when an event is fired or 'triggered' when sent from a flow.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"engine", "event", "triggered"})
+					return
+				},
+			},
+			notificationInserted: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send the notification when a record is inserted. Used when send_when is 'engine'.",
+			},
+			notificationUpdated: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send the notification when a record is updated. Used when send_when is 'engine'.",
+			},
+			notificationEventName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Name of the registered event sending the notification. Required when send_when is 'event'.",
+			},
+			notificationCondition: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Encoded query the record must match for the notification to be sent.",
+			},
+			notificationRecipientUsers: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of user record IDs or email addresses receiving the notification.",
+			},
+			notificationRecipientGroups: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of group record IDs whose members receive the notification.",
+			},
+			notificationRecipientFields: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of columns of the record holding the users or groups receiving the notification.",
+			},
+			notificationSubject: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Subject of the email. Can contain variables in the format '${column}'. Overrides the subject of the template.",
+			},
+			notificationMessageHTML: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "HTML body of the email. Can contain variables in the format '${column}' and email scripts in the format '${mail_script:name}'.",
+			},
+			notificationTemplateID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The email template record ID used to build the email.",
+			},
+			notificationWeight: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "When multiple notifications are sent to the same recipient for the same record, only the one with the highest weight is sent. Notifications with a weight of 0 are always sent.",
+			},
+			notificationActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this notification is enabled.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceNotification(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	notification := &client.Notification{}
+	if err := snowClient.GetObject(client.EndpointNotification, data.Id(), notification); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromNotification(data, notification)
+
+	return nil
+}
+
+func createResourceNotification(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	notification := resourceToNotification(data)
+	if err := snowClient.CreateObject(client.EndpointNotification, notification); err != nil {
+		return err
+	}
+
+	resourceFromNotification(data, notification)
+
+	return readResourceNotification(data, serviceNowClient)
+}
+
+func updateResourceNotification(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointNotification, resourceToNotification(data)); err != nil {
+		return err
+	}
+
+	return readResourceNotification(data, serviceNowClient)
+}
+
+func deleteResourceNotification(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointNotification, data.Id())
+}
+
+// validateNotificationSendWhen checks at plan time that the fields needed by send_when are set.
+func validateNotificationSendWhen(diff *schema.ResourceDiff, serviceNowClient interface{}) error {
+	for _, key := range []string{notificationSendWhen, notificationInserted, notificationUpdated, notificationEventName} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	switch diff.Get(notificationSendWhen).(string) {
+	case "engine":
+		if !diff.Get(notificationInserted).(bool) && !diff.Get(notificationUpdated).(bool) {
+			return fmt.Errorf("%q or %q must be true when %q is 'engine'", notificationInserted, notificationUpdated, notificationSendWhen)
+		}
+	case "event":
+		if diff.Get(notificationEventName).(string) == "" {
+			return fmt.Errorf("%q is required when %q is 'event'", notificationEventName, notificationSendWhen)
+		}
+	}
+	return nil
+}
+
+func resourceFromNotification(data *schema.ResourceData, notification *client.Notification) {
+	data.SetId(notification.ID)
+	data.Set(notificationName, notification.Name)
+	data.Set(notificationTable, notification.Table)
+	data.Set(notificationSendWhen, notification.GenerationType)
+	data.Set(notificationInserted, notification.ActionInsert)
+	data.Set(notificationUpdated, notification.ActionUpdate)
+	data.Set(notificationEventName, notification.EventName)
+	data.Set(notificationCondition, notification.Condition)
+	data.Set(notificationRecipientUsers, notification.RecipientUsers)
+	data.Set(notificationRecipientGroups, notification.RecipientGroups)
+	data.Set(notificationRecipientFields, notification.RecipientFields)
+	data.Set(notificationSubject, notification.Subject)
+	data.Set(notificationMessageHTML, notification.MessageHTML)
+	data.Set(notificationTemplateID, notification.TemplateID)
+	data.Set(notificationWeight, notification.Weight)
+	data.Set(notificationActive, notification.Active)
+	data.Set(commonScope, notification.Scope)
+}
+
+func resourceToNotification(data *schema.ResourceData) *client.Notification {
+	notification := client.Notification{
+		Name:            data.Get(notificationName).(string),
+		Table:           data.Get(notificationTable).(string),
+		GenerationType:  data.Get(notificationSendWhen).(string),
+		ActionInsert:    data.Get(notificationInserted).(bool),
+		ActionUpdate:    data.Get(notificationUpdated).(bool),
+		EventName:       data.Get(notificationEventName).(string),
+		Condition:       data.Get(notificationCondition).(string),
+		RecipientUsers:  data.Get(notificationRecipientUsers).(string),
+		RecipientGroups: data.Get(notificationRecipientGroups).(string),
+		RecipientFields: data.Get(notificationRecipientFields).(string),
+		Subject:         data.Get(notificationSubject).(string),
+		MessageHTML:     data.Get(notificationMessageHTML).(string),
+		TemplateID:      data.Get(notificationTemplateID).(string),
+		Weight:          data.Get(notificationWeight).(int),
+		Active:          data.Get(notificationActive).(bool),
+		ContentType:     "text/html",
+	}
+	notification.ID = data.Id()
+	notification.Scope = data.Get(commonScope).(string)
+	return &notification
+}
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+
 	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -8,6 +11,12 @@ import (
 const oauthEntityName = "name"
 const oauthEntityClientUUID = "client_uuid"
 const oauthEntityClientID = "client_id"
+const oauthEntityClientSecret = "client_secret"
+const oauthEntityType = "type"
+const oauthEntityDefaultGrantType = "default_grant_type"
+const oauthEntityAuthURL = "auth_url"
+const oauthEntityTokenURL = "token_url"
+const oauthEntityRevokeTokenURL = "revoke_token_url"
 const oauthEntityAccessTokenLifespan = "access_token_lifespan"
 const oauthEntityRefreshTokenLifespan = "refresh_token_lifespan"
 const oauthEntityRedirectURL = "redirect_url"
@@ -42,6 +51,52 @@ func ResourceOAuthEntity() *schema.Resource {
 				Computed:    true,
 				Description: "OAuth Client ID required during handshake.",
 			},
+			oauthEntityClientSecret: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "OAuth Client secret required during handshake. Generated when not set.",
+			},
+			oauthEntityType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "client",
+				ForceNew:    true,
+				Description: "Whether the entity is an external 'client' calling the instance, a third-party 'provider' called by the instance or a 'jwt' provider.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"client", "provider", "jwt"})
+					return
+				},
+			},
+			oauthEntityDefaultGrantType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "authorization_code",
+				Description: "The grant type used when requesting tokens from a provider. Can be any of the grant types of 'servicenow_oauth_entity_profile'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, oauthGrantTypes)
+					return
+				},
+			},
+			oauthEntityAuthURL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The URL of the provider's authorization endpoint.",
+			},
+			oauthEntityTokenURL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The URL of the provider's token endpoint.",
+			},
+			oauthEntityRevokeTokenURL: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The URL of the provider's token revocation endpoint.",
+			},
 			oauthEntityAccessTokenLifespan: {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -96,6 +151,15 @@ func readResourceOAuthEntity(data *schema.ResourceData, serviceNowClient interfa
 func createResourceOAuthEntity(data *schema.ResourceData, serviceNowClient interface{}) error {
 	snowClient := serviceNowClient.(client.ServiceNowClient)
 	oauthEntity := resourceToOAuthEntity(data)
+	oauthEntity.ClientSecret = data.Get(oauthEntityClientSecret).(string)
+	if oauthEntity.ClientSecret == "" {
+		clientSecret, err := generateOAuthClientSecret()
+		if err != nil {
+			return err
+		}
+		oauthEntity.ClientSecret = clientSecret
+		data.Set(oauthEntityClientSecret, clientSecret)
+	}
 	if err := snowClient.CreateObject(client.EndpointOAuthEntity, oauthEntity); err != nil {
 		return err
 	}
@@ -124,6 +188,11 @@ func resourceFromOAuthEntity(data *schema.ResourceData, oauthEntity *client.OAut
 	data.Set(oauthEntityName, oauthEntity.Name)
 	data.Set(oauthEntityClientUUID, oauthEntity.ClientUUID)
 	data.Set(oauthEntityClientID, oauthEntity.ClientID)
+	data.Set(oauthEntityType, oauthEntity.Type)
+	data.Set(oauthEntityDefaultGrantType, oauthEntity.DefaultGrantType)
+	data.Set(oauthEntityAuthURL, oauthEntity.AuthURL)
+	data.Set(oauthEntityTokenURL, oauthEntity.TokenURL)
+	data.Set(oauthEntityRevokeTokenURL, oauthEntity.RevokeTokenURL)
 	data.Set(oauthEntityAccessTokenLifespan, oauthEntity.AccessTokenLifespan)
 	data.Set(oauthEntityRefreshTokenLifespan, oauthEntity.RefreshTokenLifespan)
 	data.Set(oauthEntityRedirectURL, oauthEntity.RedirectURL)
@@ -135,13 +204,28 @@ func resourceFromOAuthEntity(data *schema.ResourceData, oauthEntity *client.OAut
 func resourceToOAuthEntity(data *schema.ResourceData) *client.OAuthEntity {
 	oauthEntity := client.OAuthEntity{
 		Name:                 data.Get(oauthEntityName).(string),
+		Type:                 data.Get(oauthEntityType).(string),
+		DefaultGrantType:     data.Get(oauthEntityDefaultGrantType).(string),
+		AuthURL:              data.Get(oauthEntityAuthURL).(string),
+		TokenURL:             data.Get(oauthEntityTokenURL).(string),
+		RevokeTokenURL:       data.Get(oauthEntityRevokeTokenURL).(string),
 		AccessTokenLifespan:  data.Get(oauthEntityAccessTokenLifespan).(int),
 		RefreshTokenLifespan: data.Get(oauthEntityRefreshTokenLifespan).(int),
 		RedirectURL:          data.Get(oauthEntityRedirectURL).(string),
 		LogoURL:              data.Get(oauthEntityLogoURL).(string),
 		Access:               data.Get(oauthEntityAccess).(string),
 	}
+	oauthEntity.ClientSecret = getWriteOnlyValue(data, oauthEntityClientSecret)
 	oauthEntity.ID = data.Id()
 	oauthEntity.Scope = data.Get(commonScope).(string)
 	return &oauthEntity
 }
+
+// generateOAuthClientSecret returns a random secret for entities created without one.
+func generateOAuthClientSecret() (string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,116 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const oauthEntityProfileName = "name"
+const oauthEntityProfileOAuthEntityID = "oauth_entity_id"
+const oauthEntityProfileGrantType = "grant_type"
+const oauthEntityProfileDefault = "default"
+
+var oauthGrantTypes = []string{"client_credentials", "password", "authorization_code", "refresh_token", "urn:ietf:params:oauth:grant-type:jwt-bearer"}
+
+// ResourceOAuthEntityProfile is holding the grant type used to request tokens for an OAuth entity.
+func ResourceOAuthEntityProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceOAuthEntityProfile,
+		Read:   readResourceOAuthEntityProfile,
+		Update: updateResourceOAuthEntityProfile,
+		Delete: deleteResourceOAuthEntityProfile,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			oauthEntityProfileName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			oauthEntityProfileOAuthEntityID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the OAuth entity (application registry) using this profile.",
+			},
+			oauthEntityProfileGrantType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "client_credentials",
+				Description: "Can be 'client_credentials', 'password', 'authorization_code', 'refresh_token' or 'urn:ietf:params:oauth:grant-type:jwt-bearer'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, oauthGrantTypes)
+					return
+				},
+			},
+			oauthEntityProfileDefault: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this is the default profile of the OAuth entity.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceOAuthEntityProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	oauthEntityProfile := &client.OAuthEntityProfile{}
+	if err := snowClient.GetObject(client.EndpointOAuthEntityProfile, data.Id(), oauthEntityProfile); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromOAuthEntityProfile(data, oauthEntityProfile)
+
+	return nil
+}
+
+func createResourceOAuthEntityProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	oauthEntityProfile := resourceToOAuthEntityProfile(data)
+	if err := snowClient.CreateObject(client.EndpointOAuthEntityProfile, oauthEntityProfile); err != nil {
+		return err
+	}
+
+	resourceFromOAuthEntityProfile(data, oauthEntityProfile)
+
+	return readResourceOAuthEntityProfile(data, serviceNowClient)
+}
+
+func updateResourceOAuthEntityProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointOAuthEntityProfile, resourceToOAuthEntityProfile(data)); err != nil {
+		return err
+	}
+
+	return readResourceOAuthEntityProfile(data, serviceNowClient)
+}
+
+func deleteResourceOAuthEntityProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointOAuthEntityProfile, data.Id())
+}
+
+func resourceFromOAuthEntityProfile(data *schema.ResourceData, oauthEntityProfile *client.OAuthEntityProfile) {
+	data.SetId(oauthEntityProfile.ID)
+	data.Set(oauthEntityProfileName, oauthEntityProfile.Name)
+	data.Set(oauthEntityProfileOAuthEntityID, oauthEntityProfile.OAuthEntityID)
+	data.Set(oauthEntityProfileGrantType, oauthEntityProfile.GrantType)
+	data.Set(oauthEntityProfileDefault, oauthEntityProfile.Default)
+	data.Set(commonScope, oauthEntityProfile.Scope)
+}
+
+func resourceToOAuthEntityProfile(data *schema.ResourceData) *client.OAuthEntityProfile {
+	oauthEntityProfile := client.OAuthEntityProfile{
+		Name:          data.Get(oauthEntityProfileName).(string),
+		OAuthEntityID: data.Get(oauthEntityProfileOAuthEntityID).(string),
+		GrantType:     data.Get(oauthEntityProfileGrantType).(string),
+		Default:       data.Get(oauthEntityProfileDefault).(bool),
+	}
+	oauthEntityProfile.ID = data.Id()
+	oauthEntityProfile.Scope = data.Get(commonScope).(string)
+	return &oauthEntityProfile
+}
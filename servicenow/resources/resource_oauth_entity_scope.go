@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const oauthEntityScopeName = "name"
+const oauthEntityScopeOAuthEntityID = "oauth_entity_id"
+const oauthEntityScopeOAuthScope = "oauth_scope"
+
+// ResourceOAuthEntityScope is holding a scope that can be requested for an OAuth entity.
+func ResourceOAuthEntityScope() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceOAuthEntityScope,
+		Read:   readResourceOAuthEntityScope,
+		Update: updateResourceOAuthEntityScope,
+		Delete: deleteResourceOAuthEntityScope,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			oauthEntityScopeName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The display name of the scope.",
+			},
+			oauthEntityScopeOAuthEntityID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the OAuth entity (application registry) this scope belongs to.",
+			},
+			oauthEntityScopeOAuthScope: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The scope as sent in token requests, for example 'useraccount'.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceOAuthEntityScope(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	oauthEntityScope := &client.OAuthEntityScope{}
+	if err := snowClient.GetObject(client.EndpointOAuthEntityScope, data.Id(), oauthEntityScope); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromOAuthEntityScope(data, oauthEntityScope)
+
+	return nil
+}
+
+func createResourceOAuthEntityScope(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	oauthEntityScope := resourceToOAuthEntityScope(data)
+	if err := snowClient.CreateObject(client.EndpointOAuthEntityScope, oauthEntityScope); err != nil {
+		return err
+	}
+
+	resourceFromOAuthEntityScope(data, oauthEntityScope)
+
+	return readResourceOAuthEntityScope(data, serviceNowClient)
+}
+
+func updateResourceOAuthEntityScope(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointOAuthEntityScope, resourceToOAuthEntityScope(data)); err != nil {
+		return err
+	}
+
+	return readResourceOAuthEntityScope(data, serviceNowClient)
+}
+
+func deleteResourceOAuthEntityScope(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointOAuthEntityScope, data.Id())
+}
+
+func resourceFromOAuthEntityScope(data *schema.ResourceData, oauthEntityScope *client.OAuthEntityScope) {
+	data.SetId(oauthEntityScope.ID)
+	data.Set(oauthEntityScopeName, oauthEntityScope.Name)
+	data.Set(oauthEntityScopeOAuthEntityID, oauthEntityScope.OAuthEntityID)
+	data.Set(oauthEntityScopeOAuthScope, oauthEntityScope.OAuthScope)
+	data.Set(commonScope, oauthEntityScope.Scope)
+}
+
+func resourceToOAuthEntityScope(data *schema.ResourceData) *client.OAuthEntityScope {
+	oauthEntityScope := client.OAuthEntityScope{
+		Name:          data.Get(oauthEntityScopeName).(string),
+		OAuthEntityID: data.Get(oauthEntityScopeOAuthEntityID).(string),
+		OAuthScope:    data.Get(oauthEntityScopeOAuthScope).(string),
+	}
+	oauthEntityScope.ID = data.Id()
+	oauthEntityScope.Scope = data.Get(commonScope).(string)
+	return &oauthEntityScope
+}
@@ -0,0 +1,108 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const protocolProfileName = "name"
+const protocolProfileProtocol = "protocol"
+const protocolProfileKeyStoreID = "key_store_id"
+const protocolProfileDefaultPort = "default_port"
+
+// ResourceProtocolProfile is holding the keystore presented by outbound requests using mutual authentication.
+func ResourceProtocolProfile() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceProtocolProfile,
+		Read:   readResourceProtocolProfile,
+		Update: updateResourceProtocolProfile,
+		Delete: deleteResourceProtocolProfile,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			protocolProfileName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			protocolProfileProtocol: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The protocol name referenced by the endpoints using this profile, ex: 'mutual_https'.",
+			},
+			protocolProfileKeyStoreID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the keystore certificate holding the client certificate and private key.",
+			},
+			protocolProfileDefaultPort: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  443,
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceProtocolProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	protocolProfile := &client.ProtocolProfile{}
+	if err := snowClient.GetObject(client.EndpointProtocolProfile, data.Id(), protocolProfile); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromProtocolProfile(data, protocolProfile)
+
+	return nil
+}
+
+func createResourceProtocolProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	protocolProfile := resourceToProtocolProfile(data)
+	if err := snowClient.CreateObject(client.EndpointProtocolProfile, protocolProfile); err != nil {
+		return err
+	}
+
+	resourceFromProtocolProfile(data, protocolProfile)
+
+	return readResourceProtocolProfile(data, serviceNowClient)
+}
+
+func updateResourceProtocolProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointProtocolProfile, resourceToProtocolProfile(data)); err != nil {
+		return err
+	}
+
+	return readResourceProtocolProfile(data, serviceNowClient)
+}
+
+func deleteResourceProtocolProfile(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointProtocolProfile, data.Id())
+}
+
+func resourceFromProtocolProfile(data *schema.ResourceData, protocolProfile *client.ProtocolProfile) {
+	data.SetId(protocolProfile.ID)
+	data.Set(protocolProfileName, protocolProfile.Name)
+	data.Set(protocolProfileProtocol, protocolProfile.Protocol)
+	data.Set(protocolProfileKeyStoreID, protocolProfile.KeyStoreID)
+	data.Set(protocolProfileDefaultPort, protocolProfile.DefaultPort)
+	data.Set(commonScope, protocolProfile.Scope)
+}
+
+func resourceToProtocolProfile(data *schema.ResourceData) *client.ProtocolProfile {
+	protocolProfile := client.ProtocolProfile{
+		Name:        data.Get(protocolProfileName).(string),
+		Protocol:    data.Get(protocolProfileProtocol).(string),
+		KeyStoreID:  data.Get(protocolProfileKeyStoreID).(string),
+		DefaultPort: data.Get(protocolProfileDefaultPort).(int),
+	}
+	protocolProfile.ID = data.Id()
+	protocolProfile.Scope = data.Get(commonScope).(string)
+	return &protocolProfile
+}
@@ -0,0 +1,193 @@
+package resources
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const recordProducerName = "name"
+const recordProducerShortDescription = "short_description"
+const recordProducerDescription = "description"
+const recordProducerCatalogIDs = "catalog_ids"
+const recordProducerCategoryID = "category_id"
+const recordProducerTableName = "table_name"
+const recordProducerFieldValues = "field_values"
+const recordProducerScript = "script"
+const recordProducerActive = "active"
+const recordProducerRoles = "roles"
+
+// ResourceRecordProducer is holding the info about a catalog item creating a record in a table when ordered.
+func ResourceRecordProducer() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceRecordProducer,
+		Read:   readResourceRecordProducer,
+		Update: updateResourceRecordProducer,
+		Delete: deleteResourceRecordProducer,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			recordProducerName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			recordProducerShortDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			recordProducerDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "HTML description displayed on the record producer page.",
+			},
+			recordProducerCatalogIDs: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Comma-separated list of the catalog IDs publishing the record producer.",
+			},
+			recordProducerCategoryID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the category listing the record producer.",
+			},
+			recordProducerTableName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the table in which records are created.",
+			},
+			recordProducerFieldValues: {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Static values assigned to the columns of the created record. Answers of variables are mapped with their 'target_field'.",
+			},
+			recordProducerScript: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Server script run before the record is inserted. The record is available as 'current' and the answers as 'producer'.",
+			},
+			recordProducerActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			recordProducerRoles: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of Roles (names) that can use the record producer.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceRecordProducer(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	recordProducer := &client.RecordProducer{}
+	if err := snowClient.GetObject(client.EndpointRecordProducer, data.Id(), recordProducer); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromRecordProducer(data, recordProducer)
+
+	return nil
+}
+
+func createResourceRecordProducer(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	recordProducer := resourceToRecordProducer(data)
+	if err := snowClient.CreateObject(client.EndpointRecordProducer, recordProducer); err != nil {
+		return err
+	}
+
+	resourceFromRecordProducer(data, recordProducer)
+
+	return readResourceRecordProducer(data, serviceNowClient)
+}
+
+func updateResourceRecordProducer(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointRecordProducer, resourceToRecordProducer(data)); err != nil {
+		return err
+	}
+
+	return readResourceRecordProducer(data, serviceNowClient)
+}
+
+func deleteResourceRecordProducer(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointRecordProducer, data.Id())
+}
+
+// templateToFieldValues parses a ServiceNow template, in the format 'field1=value1^field2=value2^EQ'.
+func templateToFieldValues(template string) map[string]interface{} {
+	fieldValues := map[string]interface{}{}
+	for _, condition := range strings.Split(template, "^") {
+		if parts := strings.SplitN(condition, "=", 2); len(parts) == 2 {
+			fieldValues[parts[0]] = parts[1]
+		}
+	}
+	return fieldValues
+}
+
+// fieldValuesToTemplate builds a ServiceNow template from field values, sorted by field name.
+func fieldValuesToTemplate(fieldValues map[string]interface{}) string {
+	if len(fieldValues) == 0 {
+		return ""
+	}
+	fields := make([]string, 0, len(fieldValues))
+	for field := range fieldValues {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	conditions := make([]string, 0, len(fields)+1)
+	for _, field := range fields {
+		conditions = append(conditions, field+"="+fieldValues[field].(string))
+	}
+	return strings.Join(append(conditions, "EQ"), "^")
+}
+
+func resourceFromRecordProducer(data *schema.ResourceData, recordProducer *client.RecordProducer) {
+	data.SetId(recordProducer.ID)
+	data.Set(recordProducerName, recordProducer.Name)
+	data.Set(recordProducerShortDescription, recordProducer.ShortDescription)
+	data.Set(recordProducerDescription, recordProducer.Description)
+	data.Set(recordProducerCatalogIDs, recordProducer.CatalogIDs)
+	data.Set(recordProducerCategoryID, recordProducer.CategoryID)
+	data.Set(recordProducerTableName, recordProducer.TableName)
+	data.Set(recordProducerFieldValues, templateToFieldValues(recordProducer.Template))
+	data.Set(recordProducerScript, recordProducer.Script)
+	data.Set(recordProducerActive, recordProducer.Active)
+	data.Set(recordProducerRoles, recordProducer.Roles)
+	data.Set(commonScope, recordProducer.Scope)
+}
+
+func resourceToRecordProducer(data *schema.ResourceData) *client.RecordProducer {
+	recordProducer := client.RecordProducer{
+		Name:             data.Get(recordProducerName).(string),
+		ShortDescription: data.Get(recordProducerShortDescription).(string),
+		Description:      data.Get(recordProducerDescription).(string),
+		CatalogIDs:       data.Get(recordProducerCatalogIDs).(string),
+		CategoryID:       data.Get(recordProducerCategoryID).(string),
+		TableName:        data.Get(recordProducerTableName).(string),
+		Template:         fieldValuesToTemplate(data.Get(recordProducerFieldValues).(map[string]interface{})),
+		Script:           data.Get(recordProducerScript).(string),
+		Active:           data.Get(recordProducerActive).(bool),
+		Roles:            data.Get(recordProducerRoles).(string),
+	}
+	recordProducer.ID = data.Id()
+	recordProducer.Scope = data.Get(commonScope).(string)
+	return &recordProducer
+}
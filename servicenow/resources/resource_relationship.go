@@ -0,0 +1,119 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const relationshipName = "name"
+const relationshipAppliesToTable = "applies_to_table"
+const relationshipQueriesFromTable = "queries_from_table"
+const relationshipQueryWith = "query_with"
+const relationshipInsertCallback = "insert_callback"
+
+// ResourceRelationship manages a Relationship in ServiceNow, used to display related lists between
+// tables that are not directly referencing each other.
+func ResourceRelationship() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceRelationship,
+		Read:   readResourceRelationship,
+		Update: updateResourceRelationship,
+		Delete: deleteResourceRelationship,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			relationshipName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the related list.",
+			},
+			relationshipAppliesToTable: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the table on which forms the related list can be added.",
+			},
+			relationshipQueriesFromTable: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The internal name of the table whose records are displayed in the related list.",
+			},
+			relationshipQueryWith: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Script filtering the records of the related list. The variables 'current' and 'parent' are available.",
+			},
+			relationshipInsertCallback: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script run when a new record is created from the related list. The variables 'current' and 'parent' are available.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceRelationship(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relationship := &client.Relationship{}
+	if err := snowClient.GetObject(client.EndpointRelationship, data.Id(), relationship); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromRelationship(data, relationship)
+
+	return nil
+}
+
+func createResourceRelationship(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relationship := resourceToRelationship(data)
+	if err := snowClient.CreateObject(client.EndpointRelationship, relationship); err != nil {
+		return err
+	}
+
+	resourceFromRelationship(data, relationship)
+
+	return readResourceRelationship(data, serviceNowClient)
+}
+
+func updateResourceRelationship(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointRelationship, resourceToRelationship(data)); err != nil {
+		return err
+	}
+
+	return readResourceRelationship(data, serviceNowClient)
+}
+
+func deleteResourceRelationship(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointRelationship, data.Id())
+}
+
+func resourceFromRelationship(data *schema.ResourceData, relationship *client.Relationship) {
+	data.SetId(relationship.ID)
+	data.Set(relationshipName, relationship.Name)
+	data.Set(relationshipAppliesToTable, relationship.AppliesToTable)
+	data.Set(relationshipQueriesFromTable, relationship.QueriesFromTable)
+	data.Set(relationshipQueryWith, relationship.QueryWith)
+	data.Set(relationshipInsertCallback, relationship.InsertCallback)
+	data.Set(commonScope, relationship.Scope)
+}
+
+func resourceToRelationship(data *schema.ResourceData) *client.Relationship {
+	relationship := client.Relationship{
+		Name:             data.Get(relationshipName).(string),
+		AppliesToTable:   data.Get(relationshipAppliesToTable).(string),
+		QueriesFromTable: data.Get(relationshipQueriesFromTable).(string),
+		QueryWith:        data.Get(relationshipQueryWith).(string),
+		InsertCallback:   data.Get(relationshipInsertCallback).(string),
+	}
+	relationship.ID = data.Id()
+	relationship.Scope = data.Get(commonScope).(string)
+	return &relationship
+}
@@ -0,0 +1,145 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const restAPIAccessPolicyName = "name"
+const restAPIAccessPolicyActive = "active"
+const restAPIAccessPolicyRestAPIID = "rest_api_id"
+const restAPIAccessPolicyVersionID = "version_id"
+const restAPIAccessPolicyResourceID = "resource_id"
+const restAPIAccessPolicyHTTPMethod = "http_method"
+const restAPIAccessPolicyAuthenticationPolicyID = "authentication_policy_id"
+
+// ResourceRestAPIAccessPolicy is holding the info about an access policy restricting the authentication methods of a REST API.
+func ResourceRestAPIAccessPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceRestAPIAccessPolicy,
+		Read:   readResourceRestAPIAccessPolicy,
+		Update: updateResourceRestAPIAccessPolicy,
+		Delete: deleteResourceRestAPIAccessPolicy,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			restAPIAccessPolicyName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the policy.",
+			},
+			restAPIAccessPolicyActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the policy is enforced.",
+			},
+			restAPIAccessPolicyRestAPIID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The sys_id of the Scripted REST API this policy applies to.",
+			},
+			restAPIAccessPolicyVersionID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The sys_id of the API version this policy applies to. Applies to every version when empty.",
+			},
+			restAPIAccessPolicyResourceID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The sys_id of the API resource this policy applies to. Applies to every resource when empty.",
+			},
+			restAPIAccessPolicyHTTPMethod: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The HTTP method this policy applies to. Can be 'GET', 'POST', 'PUT', 'PATCH' or 'DELETE'. Applies to every method when empty.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"", "GET", "POST", "PUT", "PATCH", "DELETE"})
+					return
+				},
+			},
+			restAPIAccessPolicyAuthenticationPolicyID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The sys_id of the authentication policy allowed to access the API.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceRestAPIAccessPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	policy := &client.RestAPIAccessPolicy{}
+	if err := snowClient.GetObject(client.EndpointRestAPIAccessPolicy, data.Id(), policy); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromRestAPIAccessPolicy(data, policy)
+
+	return nil
+}
+
+func createResourceRestAPIAccessPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	policy := resourceToRestAPIAccessPolicy(data)
+	if err := snowClient.CreateObject(client.EndpointRestAPIAccessPolicy, policy); err != nil {
+		return err
+	}
+
+	resourceFromRestAPIAccessPolicy(data, policy)
+
+	return readResourceRestAPIAccessPolicy(data, serviceNowClient)
+}
+
+func updateResourceRestAPIAccessPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointRestAPIAccessPolicy, resourceToRestAPIAccessPolicy(data)); err != nil {
+		return err
+	}
+
+	return readResourceRestAPIAccessPolicy(data, serviceNowClient)
+}
+
+func deleteResourceRestAPIAccessPolicy(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointRestAPIAccessPolicy, data.Id())
+}
+
+func resourceFromRestAPIAccessPolicy(data *schema.ResourceData, policy *client.RestAPIAccessPolicy) {
+	data.SetId(policy.ID)
+	data.Set(restAPIAccessPolicyName, policy.Name)
+	data.Set(restAPIAccessPolicyActive, policy.Active)
+	data.Set(restAPIAccessPolicyRestAPIID, policy.RestAPIID)
+	data.Set(restAPIAccessPolicyVersionID, policy.VersionID)
+	data.Set(restAPIAccessPolicyResourceID, policy.ResourceID)
+	data.Set(restAPIAccessPolicyHTTPMethod, policy.HTTPMethod)
+	data.Set(restAPIAccessPolicyAuthenticationPolicyID, policy.AuthenticationPolicyID)
+	data.Set(commonScope, policy.Scope)
+}
+
+func resourceToRestAPIAccessPolicy(data *schema.ResourceData) *client.RestAPIAccessPolicy {
+	policy := client.RestAPIAccessPolicy{
+		Name:                   data.Get(restAPIAccessPolicyName).(string),
+		Active:                 data.Get(restAPIAccessPolicyActive).(bool),
+		RestAPIID:              data.Get(restAPIAccessPolicyRestAPIID).(string),
+		VersionID:              data.Get(restAPIAccessPolicyVersionID).(string),
+		ResourceID:             data.Get(restAPIAccessPolicyResourceID).(string),
+		HTTPMethod:             data.Get(restAPIAccessPolicyHTTPMethod).(string),
+		AuthenticationPolicyID: data.Get(restAPIAccessPolicyAuthenticationPolicyID).(string),
+	}
+	policy.ApplyToAllVersions = policy.VersionID == ""
+	policy.ApplyToAllResources = policy.ResourceID == ""
+	policy.ApplyToAllMethods = policy.HTTPMethod == ""
+	policy.ID = data.Id()
+	policy.Scope = data.Get(commonScope).(string)
+	return &policy
+}
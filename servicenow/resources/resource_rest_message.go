@@ -1,7 +1,10 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -9,7 +12,14 @@ const restMessageName = "name"
 const restMessageDescription = "description"
 const restMessageRestEndpoint = "rest_endpoint"
 const restMessageAccess = "access"
-const restMessageAuthenticationType = "authentication_type" // No auth is supported
+const restMessageAuthenticationType = "authentication_type"
+const restMessageBasicAuthProfileID = "basic_auth_profile_id"
+const restMessageOAuthProfileID = "oauth_profile_id"
+const restMessageUseMutualAuth = "use_mutual_auth"
+const restMessageProtocolProfileID = "protocol_profile_id"
+const restMessageUseMidServer = "use_mid_server"
+const restMessageMidServerID = "mid_server_id"
+const restMessageMidServerClusterID = "mid_server_cluster_id"
 
 // ResourceRestMessage is holding the info about a REST message configuration to be included.
 func ResourceRestMessage() *schema.Resource {
@@ -19,6 +29,8 @@ func ResourceRestMessage() *schema.Resource {
 		Update: updateResourceRestMessage,
 		Delete: deleteResourceRestMessage,
 
+		CustomizeDiff: customdiff.All(validateRestAuthentication, validateRestConnection),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -50,6 +62,50 @@ func ResourceRestMessage() *schema.Resource {
 					return
 				},
 			},
+			restMessageAuthenticationType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "no_authentication",
+				Description: "The authentication used by the requests. Can be 'no_authentication', 'basic' or 'oauth2'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"no_authentication", "basic", "oauth2"})
+					return
+				},
+			},
+			restMessageBasicAuthProfileID: getRestBasicAuthProfileIDSchema(),
+			restMessageOAuthProfileID:     getRestOAuthProfileIDSchema(),
+			restMessageUseMutualAuth: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the requests present a client certificate, using the keystore of 'protocol_profile_id'.",
+			},
+			restMessageProtocolProfileID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the protocol profile used for mutual authentication. Required when 'use_mutual_auth' is true.",
+			},
+			restMessageUseMidServer: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the requests are sent through a MID server. Any MID server is selected when no server or cluster is set.",
+			},
+			restMessageMidServerID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{restMessageMidServerClusterID},
+				Description:   "The ID of the MID server sending the requests.",
+			},
+			restMessageMidServerClusterID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{restMessageMidServerID},
+				Description:   "The ID of the MID server cluster sending the requests.",
+			},
 			commonScope: getScopeSchema(),
 		},
 	}
@@ -94,12 +150,96 @@ func deleteResourceRestMessage(data *schema.ResourceData, serviceNowClient inter
 	return snowClient.DeleteObject(client.EndpointRestMessage, data.Id())
 }
 
+func getRestBasicAuthProfileIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The ID of the basic auth profile holding the credentials. Required when 'authentication_type' is 'basic'.",
+	}
+}
+
+func getRestOAuthProfileIDSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "The ID of the OAuth entity profile used to get tokens. Required when 'authentication_type' is 'oauth2'.",
+	}
+}
+
+// validateRestAuthentication checks at plan time that only the profile matching the authentication type is set.
+// It is shared by REST messages and methods, which use the same attribute names.
+func validateRestAuthentication(diff *schema.ResourceDiff, serviceNowClient interface{}) error {
+	for _, key := range []string{restMessageAuthenticationType, restMessageBasicAuthProfileID, restMessageOAuthProfileID} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	authenticationType := diff.Get(restMessageAuthenticationType).(string)
+	basicAuthProfileID := diff.Get(restMessageBasicAuthProfileID).(string)
+	oauthProfileID := diff.Get(restMessageOAuthProfileID).(string)
+
+	if authenticationType == "basic" && basicAuthProfileID == "" {
+		return fmt.Errorf("%q is required when %q is 'basic'", restMessageBasicAuthProfileID, restMessageAuthenticationType)
+	}
+	if authenticationType != "basic" && basicAuthProfileID != "" {
+		return fmt.Errorf("%q can only be set when %q is 'basic'", restMessageBasicAuthProfileID, restMessageAuthenticationType)
+	}
+	if authenticationType == "oauth2" && oauthProfileID == "" {
+		return fmt.Errorf("%q is required when %q is 'oauth2'", restMessageOAuthProfileID, restMessageAuthenticationType)
+	}
+	if authenticationType != "oauth2" && oauthProfileID != "" {
+		return fmt.Errorf("%q can only be set when %q is 'oauth2'", restMessageOAuthProfileID, restMessageAuthenticationType)
+	}
+
+	return nil
+}
+
+// validateRestConnection checks at plan time that the protocol profile and MID server are only set when they are used.
+// It is shared by REST messages and methods, which use the same attribute names.
+func validateRestConnection(diff *schema.ResourceDiff, serviceNowClient interface{}) error {
+	for _, key := range []string{restMessageUseMutualAuth, restMessageProtocolProfileID, restMessageUseMidServer, restMessageMidServerID, restMessageMidServerClusterID} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	useMutualAuth := diff.Get(restMessageUseMutualAuth).(bool)
+	protocolProfileID := diff.Get(restMessageProtocolProfileID).(string)
+	if useMutualAuth && protocolProfileID == "" {
+		return fmt.Errorf("%q is required when %q is true", restMessageProtocolProfileID, restMessageUseMutualAuth)
+	}
+	if !useMutualAuth && protocolProfileID != "" {
+		return fmt.Errorf("%q can only be set when %q is true", restMessageProtocolProfileID, restMessageUseMutualAuth)
+	}
+
+	if !diff.Get(restMessageUseMidServer).(bool) {
+		for _, key := range []string{restMessageMidServerID, restMessageMidServerClusterID} {
+			if diff.Get(key).(string) != "" {
+				return fmt.Errorf("%q can only be set when %q is true", key, restMessageUseMidServer)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceFromRestMessage(data *schema.ResourceData, restMessage *client.RestMessage) {
 	data.SetId(restMessage.ID)
 	data.Set(restMessageName, restMessage.Name)
 	data.Set(restMessageDescription, restMessage.Description)
 	data.Set(restMessageRestEndpoint, restMessage.RestEndpoint)
 	data.Set(restMessageAccess, restMessage.Access)
+	data.Set(restMessageAuthenticationType, restMessage.AuthenticationType)
+	data.Set(restMessageBasicAuthProfileID, restMessage.BasicAuthProfileID)
+	data.Set(restMessageOAuthProfileID, restMessage.OAuthProfileID)
+	data.Set(restMessageUseMutualAuth, restMessage.UseMutualAuth)
+	data.Set(restMessageProtocolProfileID, restMessage.ProtocolProfileID)
+	data.Set(restMessageUseMidServer, restMessage.UseMidServer)
+	data.Set(restMessageMidServerID, restMessage.MidServerID)
+	data.Set(restMessageMidServerClusterID, restMessage.MidServerClusterID)
 	data.Set(commonScope, restMessage.Scope)
 }
 
@@ -109,7 +249,14 @@ func resourceToRestMessage(data *schema.ResourceData) *client.RestMessage {
 		Description:        data.Get(restMessageDescription).(string),
 		RestEndpoint:       data.Get(restMessageRestEndpoint).(string),
 		Access:             data.Get(restMessageAccess).(string),
-		AuthenticationType: "no_authentication",
+		AuthenticationType: data.Get(restMessageAuthenticationType).(string),
+		BasicAuthProfileID: data.Get(restMessageBasicAuthProfileID).(string),
+		OAuthProfileID:     data.Get(restMessageOAuthProfileID).(string),
+		UseMutualAuth:      data.Get(restMessageUseMutualAuth).(bool),
+		ProtocolProfileID:  data.Get(restMessageProtocolProfileID).(string),
+		UseMidServer:       data.Get(restMessageUseMidServer).(bool),
+		MidServerID:        data.Get(restMessageMidServerID).(string),
+		MidServerClusterID: data.Get(restMessageMidServerClusterID).(string),
 	}
 	restMessage.ID = data.Id()
 	restMessage.Scope = data.Get(commonScope).(string)
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/schema"
 )
 
@@ -11,6 +12,14 @@ const restMethodHTTPMethod = "http_method"
 const restMethodRestEndpoint = "rest_endpoint"
 const restMethodAuthenticationType = "authentication_type"
 const restMethodQualifiedName = "qualified_name"
+const restMethodContent = "content"
+const restMethodBasicAuthProfileID = "basic_auth_profile_id"
+const restMethodOAuthProfileID = "oauth_profile_id"
+const restMethodUseMutualAuth = "use_mutual_auth"
+const restMethodProtocolProfileID = "protocol_profile_id"
+const restMethodUseMidServer = "use_mid_server"
+const restMethodMidServerID = "mid_server_id"
+const restMethodMidServerClusterID = "mid_server_cluster_id"
 
 // ResourceRestMethod is holding the info about a REST method to be included in a REST message.
 func ResourceRestMethod() *schema.Resource {
@@ -20,6 +29,8 @@ func ResourceRestMethod() *schema.Resource {
 		Update: updateResourceRestMethod,
 		Delete: deleteResourceRestMethod,
 
+		CustomizeDiff: customdiff.All(validateRestAuthentication, validateRestConnection),
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -50,6 +61,56 @@ func ResourceRestMethod() *schema.Resource {
 				Default:     "",
 				Description: "The URL of the REST web service provider this method sends requests to. Can contain variables in the format '${variable}'.",
 			},
+			restMethodContent: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The body of the HTTP request, for 'post', 'put' and 'patch' methods. Can contain variables in the format '${variable}'.",
+			},
+			restMethodAuthenticationType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "inherit_from_parent",
+				Description: "The authentication used by the requests. Can be 'inherit_from_parent', 'no_authentication', 'basic' or 'oauth2'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"inherit_from_parent", "no_authentication", "basic", "oauth2"})
+					return
+				},
+			},
+			restMethodBasicAuthProfileID: getRestBasicAuthProfileIDSchema(),
+			restMethodOAuthProfileID:     getRestOAuthProfileIDSchema(),
+			restMethodUseMutualAuth: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the requests present a client certificate, using the keystore of 'protocol_profile_id'.",
+			},
+			restMethodProtocolProfileID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the protocol profile used for mutual authentication. Required when 'use_mutual_auth' is true.",
+			},
+			restMethodUseMidServer: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the requests are sent through a MID server. Any MID server is selected when no server or cluster is set.",
+			},
+			restMethodMidServerID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{restMethodMidServerClusterID},
+				Description:   "The ID of the MID server sending the requests.",
+			},
+			restMethodMidServerClusterID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{restMethodMidServerID},
+				Description:   "The ID of the MID server cluster sending the requests.",
+			},
 			restMethodQualifiedName: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -104,6 +165,15 @@ func resourceFromRestMethod(data *schema.ResourceData, restMethod *client.RestMe
 	data.Set(restMethodMessageID, restMethod.MessageID)
 	data.Set(restMethodHTTPMethod, restMethod.HTTPMethod)
 	data.Set(restMethodRestEndpoint, restMethod.RestEndpoint)
+	data.Set(restMethodContent, restMethod.Content)
+	data.Set(restMethodAuthenticationType, restMethod.AuthenticationType)
+	data.Set(restMethodBasicAuthProfileID, restMethod.BasicAuthProfileID)
+	data.Set(restMethodOAuthProfileID, restMethod.OAuthProfileID)
+	data.Set(restMethodUseMutualAuth, restMethod.UseMutualAuth)
+	data.Set(restMethodProtocolProfileID, restMethod.ProtocolProfileID)
+	data.Set(restMethodUseMidServer, restMethod.UseMidServer)
+	data.Set(restMethodMidServerID, restMethod.MidServerID)
+	data.Set(restMethodMidServerClusterID, restMethod.MidServerClusterID)
 	data.Set(restMethodQualifiedName, restMethod.QualifiedName)
 	data.Set(commonScope, restMethod.Scope)
 }
@@ -114,7 +184,15 @@ func resourceToRestMethod(data *schema.ResourceData) *client.RestMethod {
 		MessageID:          data.Get(restMethodMessageID).(string),
 		HTTPMethod:         data.Get(restMethodHTTPMethod).(string),
 		RestEndpoint:       data.Get(restMethodRestEndpoint).(string),
-		AuthenticationType: "inherit_from_parent",
+		AuthenticationType: data.Get(restMethodAuthenticationType).(string),
+		BasicAuthProfileID: data.Get(restMethodBasicAuthProfileID).(string),
+		OAuthProfileID:     data.Get(restMethodOAuthProfileID).(string),
+		UseMutualAuth:      data.Get(restMethodUseMutualAuth).(bool),
+		ProtocolProfileID:  data.Get(restMethodProtocolProfileID).(string),
+		UseMidServer:       data.Get(restMethodUseMidServer).(bool),
+		MidServerID:        data.Get(restMethodMidServerID).(string),
+		MidServerClusterID: data.Get(restMethodMidServerClusterID).(string),
+		Content:            data.Get(restMethodContent).(string),
 	}
 	restMethod.ID = data.Id()
 	restMethod.Scope = data.Get(commonScope).(string)
@@ -0,0 +1,102 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const restMethodParameterName = "name"
+const restMethodParameterValue = "value"
+const restMethodParameterMethodID = "rest_method_id"
+
+// ResourceRestMethodParameter is holding the info about a variable substituted in the endpoint, headers or content of a REST method.
+func ResourceRestMethodParameter() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceRestMethodParameter,
+		Read:   readResourceRestMethodParameter,
+		Update: updateResourceRestMethodParameter,
+		Delete: deleteResourceRestMethodParameter,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			restMethodParameterName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the variable, referenced as '${name}'.",
+			},
+			restMethodParameterValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The default value of the variable, used when it is not set by the script calling the method.",
+			},
+			restMethodParameterMethodID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The REST method record ID using this variable.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceRestMethodParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	restMethodParameter := &client.RestMethodParameter{}
+	if err := snowClient.GetObject(client.EndpointRestMethodParameter, data.Id(), restMethodParameter); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromRestMethodParameter(data, restMethodParameter)
+
+	return nil
+}
+
+func createResourceRestMethodParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	restMethodParameter := resourceToRestMethodParameter(data)
+	if err := snowClient.CreateObject(client.EndpointRestMethodParameter, restMethodParameter); err != nil {
+		return err
+	}
+
+	resourceFromRestMethodParameter(data, restMethodParameter)
+
+	return readResourceRestMethodParameter(data, serviceNowClient)
+}
+
+func updateResourceRestMethodParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointRestMethodParameter, resourceToRestMethodParameter(data)); err != nil {
+		return err
+	}
+
+	return readResourceRestMethodParameter(data, serviceNowClient)
+}
+
+func deleteResourceRestMethodParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointRestMethodParameter, data.Id())
+}
+
+func resourceFromRestMethodParameter(data *schema.ResourceData, restMethodParameter *client.RestMethodParameter) {
+	data.SetId(restMethodParameter.ID)
+	data.Set(restMethodParameterName, restMethodParameter.Name)
+	data.Set(restMethodParameterValue, restMethodParameter.Value)
+	data.Set(restMethodParameterMethodID, restMethodParameter.MethodID)
+	data.Set(commonScope, restMethodParameter.Scope)
+}
+
+func resourceToRestMethodParameter(data *schema.ResourceData) *client.RestMethodParameter {
+	restMethodParameter := client.RestMethodParameter{
+		Name:     data.Get(restMethodParameterName).(string),
+		Value:    data.Get(restMethodParameterValue).(string),
+		MethodID: data.Get(restMethodParameterMethodID).(string),
+	}
+	restMethodParameter.ID = data.Id()
+	restMethodParameter.Scope = data.Get(commonScope).(string)
+	return &restMethodParameter
+}
@@ -0,0 +1,110 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const restMethodQueryParameterName = "name"
+const restMethodQueryParameterValue = "value"
+const restMethodQueryParameterMethodID = "rest_method_id"
+const restMethodQueryParameterOrder = "order"
+
+// ResourceRestMethodQueryParameter is holding the info about a query parameter to be added to the URL of a REST method.
+func ResourceRestMethodQueryParameter() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceRestMethodQueryParameter,
+		Read:   readResourceRestMethodQueryParameter,
+		Update: updateResourceRestMethodQueryParameter,
+		Delete: deleteResourceRestMethodQueryParameter,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			restMethodQueryParameterName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the query parameter to add to the HTTP request.",
+			},
+			restMethodQueryParameterValue: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The value of the query parameter. Can contain variables in the format '${variable}'.",
+			},
+			restMethodQueryParameterMethodID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The REST method record ID this query parameter will be applied to.",
+			},
+			restMethodQueryParameterOrder: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The position of the query parameter in the URL.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceRestMethodQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	restMethodQueryParameter := &client.RestMethodQueryParameter{}
+	if err := snowClient.GetObject(client.EndpointRestMethodQueryParameter, data.Id(), restMethodQueryParameter); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromRestMethodQueryParameter(data, restMethodQueryParameter)
+
+	return nil
+}
+
+func createResourceRestMethodQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	restMethodQueryParameter := resourceToRestMethodQueryParameter(data)
+	if err := snowClient.CreateObject(client.EndpointRestMethodQueryParameter, restMethodQueryParameter); err != nil {
+		return err
+	}
+
+	resourceFromRestMethodQueryParameter(data, restMethodQueryParameter)
+
+	return readResourceRestMethodQueryParameter(data, serviceNowClient)
+}
+
+func updateResourceRestMethodQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointRestMethodQueryParameter, resourceToRestMethodQueryParameter(data)); err != nil {
+		return err
+	}
+
+	return readResourceRestMethodQueryParameter(data, serviceNowClient)
+}
+
+func deleteResourceRestMethodQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointRestMethodQueryParameter, data.Id())
+}
+
+func resourceFromRestMethodQueryParameter(data *schema.ResourceData, restMethodQueryParameter *client.RestMethodQueryParameter) {
+	data.SetId(restMethodQueryParameter.ID)
+	data.Set(restMethodQueryParameterName, restMethodQueryParameter.Name)
+	data.Set(restMethodQueryParameterValue, restMethodQueryParameter.Value)
+	data.Set(restMethodQueryParameterMethodID, restMethodQueryParameter.MethodID)
+	data.Set(restMethodQueryParameterOrder, restMethodQueryParameter.Order)
+	data.Set(commonScope, restMethodQueryParameter.Scope)
+}
+
+func resourceToRestMethodQueryParameter(data *schema.ResourceData) *client.RestMethodQueryParameter {
+	restMethodQueryParameter := client.RestMethodQueryParameter{
+		Name:     data.Get(restMethodQueryParameterName).(string),
+		Value:    data.Get(restMethodQueryParameterValue).(string),
+		MethodID: data.Get(restMethodQueryParameterMethodID).(string),
+		Order:    data.Get(restMethodQueryParameterOrder).(int),
+	}
+	restMethodQueryParameter.ID = data.Id()
+	restMethodQueryParameter.Scope = data.Get(commonScope).(string)
+	return &restMethodQueryParameter
+}
@@ -0,0 +1,165 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const restRateLimitName = "name"
+const restRateLimitActive = "active"
+const restRateLimitRestAPIID = "rest_api_id"
+const restRateLimitResourceID = "resource_id"
+const restRateLimitHTTPMethod = "http_method"
+const restRateLimitAppliesTo = "applies_to"
+const restRateLimitUserID = "user_id"
+const restRateLimitRoleID = "role_id"
+const restRateLimitMaxPerHour = "max_requests_per_hour"
+
+// ResourceRestRateLimit is holding the info about a rule throttling the requests made to a REST API.
+func ResourceRestRateLimit() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceRestRateLimit,
+		Read:   readResourceRestRateLimit,
+		Update: updateResourceRestRateLimit,
+		Delete: deleteResourceRestRateLimit,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			restRateLimitName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the rule.",
+			},
+			restRateLimitActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the rule is enforced.",
+			},
+			restRateLimitRestAPIID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The sys_id of the Scripted REST API to throttle.",
+			},
+			restRateLimitResourceID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The sys_id of the API resource to throttle. Applies to every resource when empty.",
+			},
+			restRateLimitHTTPMethod: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The HTTP method to throttle. Can be 'GET', 'POST', 'PUT', 'PATCH' or 'DELETE'. Applies to every method when empty.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"", "GET", "POST", "PUT", "PATCH", "DELETE"})
+					return
+				},
+			},
+			restRateLimitAppliesTo: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "everyone",
+				Description: "Who the rule counts requests for. Can be 'everyone', 'user' or 'role'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"everyone", "user", "role"})
+					return
+				},
+			},
+			restRateLimitUserID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{restRateLimitRoleID},
+				Description:   "The sys_id of the user to throttle when 'applies_to' is 'user'.",
+			},
+			restRateLimitRoleID: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Default:       "",
+				ConflictsWith: []string{restRateLimitUserID},
+				Description:   "The sys_id of the role to throttle when 'applies_to' is 'role'.",
+			},
+			restRateLimitMaxPerHour: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The number of requests allowed per hour before requests are rejected.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceRestRateLimit(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	rateLimit := &client.RestRateLimit{}
+	if err := snowClient.GetObject(client.EndpointRestRateLimit, data.Id(), rateLimit); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromRestRateLimit(data, rateLimit)
+
+	return nil
+}
+
+func createResourceRestRateLimit(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	rateLimit := resourceToRestRateLimit(data)
+	if err := snowClient.CreateObject(client.EndpointRestRateLimit, rateLimit); err != nil {
+		return err
+	}
+
+	resourceFromRestRateLimit(data, rateLimit)
+
+	return readResourceRestRateLimit(data, serviceNowClient)
+}
+
+func updateResourceRestRateLimit(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointRestRateLimit, resourceToRestRateLimit(data)); err != nil {
+		return err
+	}
+
+	return readResourceRestRateLimit(data, serviceNowClient)
+}
+
+func deleteResourceRestRateLimit(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointRestRateLimit, data.Id())
+}
+
+func resourceFromRestRateLimit(data *schema.ResourceData, rateLimit *client.RestRateLimit) {
+	data.SetId(rateLimit.ID)
+	data.Set(restRateLimitName, rateLimit.Name)
+	data.Set(restRateLimitActive, rateLimit.Active)
+	data.Set(restRateLimitRestAPIID, rateLimit.RestAPIID)
+	data.Set(restRateLimitResourceID, rateLimit.ResourceID)
+	data.Set(restRateLimitHTTPMethod, rateLimit.HTTPMethod)
+	data.Set(restRateLimitAppliesTo, rateLimit.AppliesTo)
+	data.Set(restRateLimitUserID, rateLimit.UserID)
+	data.Set(restRateLimitRoleID, rateLimit.RoleID)
+	data.Set(restRateLimitMaxPerHour, rateLimit.MaxPerHour)
+	data.Set(commonScope, rateLimit.Scope)
+}
+
+func resourceToRestRateLimit(data *schema.ResourceData) *client.RestRateLimit {
+	rateLimit := client.RestRateLimit{
+		Name:       data.Get(restRateLimitName).(string),
+		Active:     data.Get(restRateLimitActive).(bool),
+		RestAPIID:  data.Get(restRateLimitRestAPIID).(string),
+		ResourceID: data.Get(restRateLimitResourceID).(string),
+		HTTPMethod: data.Get(restRateLimitHTTPMethod).(string),
+		AppliesTo:  data.Get(restRateLimitAppliesTo).(string),
+		UserID:     data.Get(restRateLimitUserID).(string),
+		RoleID:     data.Get(restRateLimitRoleID).(string),
+		MaxPerHour: data.Get(restRateLimitMaxPerHour).(int),
+	}
+	rateLimit.ID = data.Id()
+	rateLimit.Scope = data.Get(commonScope).(string)
+	return &rateLimit
+}
@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"strings"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scheduledImportName = "name"
+const scheduledImportDataSourceID = "data_source_id"
+const scheduledImportPreScript = "pre_script"
+const scheduledImportPostScript = "post_script"
+
+// ResourceScheduledImport manages a Scheduled Data Import in ServiceNow, loading and transforming the rows
+// of a data source on a schedule. The schedule attributes are the same as for 'servicenow_scheduled_job'.
+func ResourceScheduledImport() *schema.Resource {
+	jobSchema := ResourceScheduledJob().Schema
+
+	return &schema.Resource{
+		Create: createResourceScheduledImport,
+		Read:   readResourceScheduledImport,
+		Update: updateResourceScheduledImport,
+		Delete: deleteResourceScheduledImport,
+
+		CustomizeDiff: validateScheduledJobRunType,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scheduledImportName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the scheduled import.",
+			},
+			scheduledImportDataSourceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the data source to load.",
+			},
+			scheduledJobRunType:        jobSchema[scheduledJobRunType],
+			scheduledJobTime:           jobSchema[scheduledJobTime],
+			scheduledJobDayOfWeek:      jobSchema[scheduledJobDayOfWeek],
+			scheduledJobDayOfMonth:     jobSchema[scheduledJobDayOfMonth],
+			scheduledJobRepeatInterval: jobSchema[scheduledJobRepeatInterval],
+			scheduledJobStart:          jobSchema[scheduledJobStart],
+			scheduledJobConditional:    jobSchema[scheduledJobConditional],
+			scheduledJobCondition:      jobSchema[scheduledJobCondition],
+			scheduledJobRunAsID:        jobSchema[scheduledJobRunAsID],
+			scheduledJobActive:         jobSchema[scheduledJobActive],
+			scheduledImportPreScript: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script run before the data is loaded.",
+			},
+			scheduledImportPostScript: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script run after the data is loaded and transformed.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScheduledImport(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scheduledImport := &client.ScheduledImport{}
+	if err := snowClient.GetObject(client.EndpointScheduledImport, data.Id(), scheduledImport); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScheduledImport(data, scheduledImport)
+
+	return nil
+}
+
+func createResourceScheduledImport(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scheduledImport := resourceToScheduledImport(data)
+	if err := snowClient.CreateObject(client.EndpointScheduledImport, scheduledImport); err != nil {
+		return err
+	}
+
+	resourceFromScheduledImport(data, scheduledImport)
+
+	return readResourceScheduledImport(data, serviceNowClient)
+}
+
+func updateResourceScheduledImport(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScheduledImport, resourceToScheduledImport(data)); err != nil {
+		return err
+	}
+
+	return readResourceScheduledImport(data, serviceNowClient)
+}
+
+func deleteResourceScheduledImport(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScheduledImport, data.Id())
+}
+
+func resourceFromScheduledImport(data *schema.ResourceData, scheduledImport *client.ScheduledImport) {
+	data.SetId(scheduledImport.ID)
+	data.Set(scheduledImportName, scheduledImport.Name)
+	data.Set(scheduledImportDataSourceID, scheduledImport.DataSourceID)
+	data.Set(scheduledJobRunType, scheduledImport.RunType)
+	data.Set(scheduledJobTime, strings.TrimPrefix(scheduledImport.RunTime, scheduledJobEpochDate))
+	data.Set(scheduledJobDayOfWeek, atoiOrZero(scheduledImport.RunDayOfWeek))
+	data.Set(scheduledJobDayOfMonth, atoiOrZero(scheduledImport.RunDayOfMonth))
+	data.Set(scheduledJobRepeatInterval, durationToSeconds(scheduledImport.RunPeriod))
+	data.Set(scheduledJobStart, scheduledImport.RunStart)
+	data.Set(scheduledJobConditional, scheduledImport.Conditional)
+	data.Set(scheduledJobCondition, scheduledImport.Condition)
+	data.Set(scheduledJobRunAsID, scheduledImport.RunAsID)
+	data.Set(scheduledJobActive, scheduledImport.Active)
+	data.Set(scheduledImportPreScript, scheduledImport.PreScript)
+	data.Set(scheduledImportPostScript, scheduledImport.PostScript)
+	data.Set(commonScope, scheduledImport.Scope)
+}
+
+func resourceToScheduledImport(data *schema.ResourceData) *client.ScheduledImport {
+	scheduledImport := client.ScheduledImport{
+		Name:          data.Get(scheduledImportName).(string),
+		DataSourceID:  data.Get(scheduledImportDataSourceID).(string),
+		RunType:       data.Get(scheduledJobRunType).(string),
+		RunDayOfWeek:  itoaOrEmpty(data.Get(scheduledJobDayOfWeek).(int)),
+		RunDayOfMonth: itoaOrEmpty(data.Get(scheduledJobDayOfMonth).(int)),
+		RunPeriod:     secondsToDuration(data.Get(scheduledJobRepeatInterval).(int)),
+		RunStart:      data.Get(scheduledJobStart).(string),
+		Conditional:   data.Get(scheduledJobConditional).(bool),
+		Condition:     data.Get(scheduledJobCondition).(string),
+		RunAsID:       data.Get(scheduledJobRunAsID).(string),
+		Active:        data.Get(scheduledJobActive).(bool),
+		PreScript:     data.Get(scheduledImportPreScript).(string),
+		PostScript:    data.Get(scheduledImportPostScript).(string),
+	}
+	if runTime := data.Get(scheduledJobTime).(string); runTime != "" {
+		scheduledImport.RunTime = scheduledJobEpochDate + runTime
+	}
+	scheduledImport.ID = data.Id()
+	scheduledImport.Scope = data.Get(commonScope).(string)
+	return &scheduledImport
+}
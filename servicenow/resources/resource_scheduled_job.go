@@ -0,0 +1,265 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scheduledJobName = "name"
+const scheduledJobRunType = "run_type"
+const scheduledJobTime = "time"
+const scheduledJobDayOfWeek = "day_of_week"
+const scheduledJobDayOfMonth = "day_of_month"
+const scheduledJobRepeatInterval = "repeat_interval"
+const scheduledJobStart = "start"
+const scheduledJobConditional = "conditional"
+const scheduledJobCondition = "condition"
+const scheduledJobRunAsID = "run_as_id"
+const scheduledJobActive = "active"
+const scheduledJobScript = "script"
+
+// Times and durations are stored as date-times relative to the epoch in ServiceNow.
+const scheduledJobDateTimeFormat = "2006-01-02 15:04:05"
+const scheduledJobEpochDate = "1970-01-01 "
+
+// ResourceScheduledJob manages a Scheduled Script Job in ServiceNow.
+func ResourceScheduledJob() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScheduledJob,
+		Read:   readResourceScheduledJob,
+		Update: updateResourceScheduledJob,
+		Delete: deleteResourceScheduledJob,
+
+		CustomizeDiff: validateScheduledJobRunType,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scheduledJobName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the scheduled job.",
+			},
+			scheduledJobRunType: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "How the job is triggered. Can be 'daily', 'weekly', 'monthly', 'periodically', 'once' or 'on_demand'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"daily", "weekly", "monthly", "periodically", "once", "on_demand"})
+					return
+				},
+			},
+			scheduledJobTime: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Time of the day the job runs, in the format 'HH:MM:SS' (UTC). Required when the run type is 'daily', 'weekly' or 'monthly'.",
+			},
+			scheduledJobDayOfWeek: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Day of the week the job runs, from 1 (Monday) to 7 (Sunday). Required when the run type is 'weekly'.",
+			},
+			scheduledJobDayOfMonth: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Day of the month the job runs, from 1 to 31. Required when the run type is 'monthly'.",
+			},
+			scheduledJobRepeatInterval: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Number of seconds between two runs. Required when the run type is 'periodically'.",
+			},
+			scheduledJobStart: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Date and time of the first run, in the format 'YYYY-MM-DD HH:MM:SS' (UTC). Required when the run type is 'once'.",
+			},
+			scheduledJobConditional: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the condition script must return true for the job to run.",
+			},
+			scheduledJobCondition: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script evaluated before each run when the job is conditional. The job only runs if the last statement evaluates to true.",
+			},
+			scheduledJobRunAsID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The user record ID the job runs as. Runs as the system administrator when empty.",
+			},
+			scheduledJobActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this scheduled job is enabled.",
+			},
+			scheduledJobScript: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Javascript script to run.",
+			},
+			commonProtectionPolicy: getProtectionPolicySchema(),
+			commonScope:            getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScheduledJob(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scheduledJob := &client.ScheduledJob{}
+	if err := snowClient.GetObject(client.EndpointScheduledJob, data.Id(), scheduledJob); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScheduledJob(data, scheduledJob)
+
+	return nil
+}
+
+func createResourceScheduledJob(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scheduledJob := resourceToScheduledJob(data)
+	if err := snowClient.CreateObject(client.EndpointScheduledJob, scheduledJob); err != nil {
+		return err
+	}
+
+	resourceFromScheduledJob(data, scheduledJob)
+
+	return readResourceScheduledJob(data, serviceNowClient)
+}
+
+func updateResourceScheduledJob(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScheduledJob, resourceToScheduledJob(data)); err != nil {
+		return err
+	}
+
+	return readResourceScheduledJob(data, serviceNowClient)
+}
+
+func deleteResourceScheduledJob(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScheduledJob, data.Id())
+}
+
+// validateScheduledJobRunType checks at plan time that the fields needed by the run type are set.
+func validateScheduledJobRunType(diff *schema.ResourceDiff, serviceNowClient interface{}) error {
+	for _, key := range []string{scheduledJobRunType, scheduledJobTime, scheduledJobDayOfWeek, scheduledJobDayOfMonth, scheduledJobRepeatInterval, scheduledJobStart, scheduledJobConditional, scheduledJobCondition} {
+		if !diff.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	runType := diff.Get(scheduledJobRunType).(string)
+	if runType == "daily" || runType == "weekly" || runType == "monthly" {
+		if _, err := time.Parse("15:04:05", diff.Get(scheduledJobTime).(string)); err != nil {
+			return fmt.Errorf("%q must be in the format 'HH:MM:SS' when %q is '%s'", scheduledJobTime, scheduledJobRunType, runType)
+		}
+	}
+
+	switch runType {
+	case "weekly":
+		if dayOfWeek := diff.Get(scheduledJobDayOfWeek).(int); dayOfWeek < 1 || dayOfWeek > 7 {
+			return fmt.Errorf("%q must be between 1 and 7 when %q is 'weekly', got: %d", scheduledJobDayOfWeek, scheduledJobRunType, dayOfWeek)
+		}
+	case "monthly":
+		if dayOfMonth := diff.Get(scheduledJobDayOfMonth).(int); dayOfMonth < 1 || dayOfMonth > 31 {
+			return fmt.Errorf("%q must be between 1 and 31 when %q is 'monthly', got: %d", scheduledJobDayOfMonth, scheduledJobRunType, dayOfMonth)
+		}
+	case "periodically":
+		if diff.Get(scheduledJobRepeatInterval).(int) <= 0 {
+			return fmt.Errorf("%q must be greater than 0 when %q is 'periodically'", scheduledJobRepeatInterval, scheduledJobRunType)
+		}
+	case "once":
+		if diff.Get(scheduledJobStart).(string) == "" {
+			return fmt.Errorf("%q is required when %q is 'once'", scheduledJobStart, scheduledJobRunType)
+		}
+	}
+
+	if start := diff.Get(scheduledJobStart).(string); start != "" {
+		if _, err := time.Parse(scheduledJobDateTimeFormat, start); err != nil {
+			return fmt.Errorf("%q must be in the format 'YYYY-MM-DD HH:MM:SS', got: %s", scheduledJobStart, start)
+		}
+	}
+
+	if diff.Get(scheduledJobConditional).(bool) && diff.Get(scheduledJobCondition).(string) == "" {
+		return fmt.Errorf("%q is required when %q is true", scheduledJobCondition, scheduledJobConditional)
+	}
+
+	return nil
+}
+
+func resourceFromScheduledJob(data *schema.ResourceData, scheduledJob *client.ScheduledJob) {
+	data.SetId(scheduledJob.ID)
+	data.Set(scheduledJobName, scheduledJob.Name)
+	data.Set(scheduledJobRunType, scheduledJob.RunType)
+	data.Set(scheduledJobTime, strings.TrimPrefix(scheduledJob.RunTime, scheduledJobEpochDate))
+	data.Set(scheduledJobDayOfWeek, atoiOrZero(scheduledJob.RunDayOfWeek))
+	data.Set(scheduledJobDayOfMonth, atoiOrZero(scheduledJob.RunDayOfMonth))
+	data.Set(scheduledJobRepeatInterval, durationToSeconds(scheduledJob.RunPeriod))
+	data.Set(scheduledJobStart, scheduledJob.RunStart)
+	data.Set(scheduledJobConditional, scheduledJob.Conditional)
+	data.Set(scheduledJobCondition, scheduledJob.Condition)
+	data.Set(scheduledJobRunAsID, scheduledJob.RunAsID)
+	data.Set(scheduledJobActive, scheduledJob.Active)
+	data.Set(scheduledJobScript, scheduledJob.Script)
+	data.Set(commonProtectionPolicy, scheduledJob.ProtectionPolicy)
+	data.Set(commonScope, scheduledJob.Scope)
+}
+
+func resourceToScheduledJob(data *schema.ResourceData) *client.ScheduledJob {
+	scheduledJob := client.ScheduledJob{
+		Name:          data.Get(scheduledJobName).(string),
+		RunType:       data.Get(scheduledJobRunType).(string),
+		RunDayOfWeek:  itoaOrEmpty(data.Get(scheduledJobDayOfWeek).(int)),
+		RunDayOfMonth: itoaOrEmpty(data.Get(scheduledJobDayOfMonth).(int)),
+		RunPeriod:     secondsToDuration(data.Get(scheduledJobRepeatInterval).(int)),
+		RunStart:      data.Get(scheduledJobStart).(string),
+		Conditional:   data.Get(scheduledJobConditional).(bool),
+		Condition:     data.Get(scheduledJobCondition).(string),
+		RunAsID:       data.Get(scheduledJobRunAsID).(string),
+		Active:        data.Get(scheduledJobActive).(bool),
+		Script:        data.Get(scheduledJobScript).(string),
+	}
+	if runTime := data.Get(scheduledJobTime).(string); runTime != "" {
+		scheduledJob.RunTime = scheduledJobEpochDate + runTime
+	}
+	scheduledJob.ID = data.Id()
+	scheduledJob.ProtectionPolicy = data.Get(commonProtectionPolicy).(string)
+	scheduledJob.Scope = data.Get(commonScope).(string)
+	return &scheduledJob
+}
+
+// secondsToDuration converts a number of seconds to a ServiceNow duration, which is a date-time relative to the epoch.
+func secondsToDuration(seconds int) string {
+	if seconds <= 0 {
+		return ""
+	}
+	return time.Unix(int64(seconds), 0).UTC().Format(scheduledJobDateTimeFormat)
+}
+
+// durationToSeconds converts a ServiceNow duration to a number of seconds. Invalid durations are 0.
+func durationToSeconds(duration string) int {
+	parsed, err := time.Parse(scheduledJobDateTimeFormat, duration)
+	if err != nil {
+		return 0
+	}
+	return int(parsed.Unix())
+}
@@ -0,0 +1,131 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptActionName = "name"
+const scriptActionEventName = "event_name"
+const scriptActionCondition = "condition"
+const scriptActionScript = "script"
+const scriptActionOrder = "order"
+const scriptActionActive = "active"
+
+// ResourceScriptAction manages a Script Action in ServiceNow, run when a specific event is processed.
+func ResourceScriptAction() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptAction,
+		Read:   readResourceScriptAction,
+		Update: updateResourceScriptAction,
+		Delete: deleteResourceScriptAction,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scriptActionName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the script action.",
+			},
+			scriptActionEventName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the registered event triggering the script.",
+			},
+			scriptActionScript: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Javascript script to run when the event is processed. The variables 'current' and 'event' are available.",
+			},
+			scriptActionCondition: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script that must evaluate to true for the action to run.",
+			},
+			scriptActionOrder: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The order in which the script actions of the same event are run.",
+			},
+			scriptActionActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this script action is enabled.",
+			},
+			commonProtectionPolicy: getProtectionPolicySchema(),
+			commonScope:            getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptAction := &client.ScriptAction{}
+	if err := snowClient.GetObject(client.EndpointScriptAction, data.Id(), scriptAction); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScriptAction(data, scriptAction)
+
+	return nil
+}
+
+func createResourceScriptAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptAction := resourceToScriptAction(data)
+	if err := snowClient.CreateObject(client.EndpointScriptAction, scriptAction); err != nil {
+		return err
+	}
+
+	resourceFromScriptAction(data, scriptAction)
+
+	return readResourceScriptAction(data, serviceNowClient)
+}
+
+func updateResourceScriptAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScriptAction, resourceToScriptAction(data)); err != nil {
+		return err
+	}
+
+	return readResourceScriptAction(data, serviceNowClient)
+}
+
+func deleteResourceScriptAction(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScriptAction, data.Id())
+}
+
+func resourceFromScriptAction(data *schema.ResourceData, scriptAction *client.ScriptAction) {
+	data.SetId(scriptAction.ID)
+	data.Set(scriptActionName, scriptAction.Name)
+	data.Set(scriptActionEventName, scriptAction.EventName)
+	data.Set(scriptActionCondition, scriptAction.ConditionScript)
+	data.Set(scriptActionScript, scriptAction.Script)
+	data.Set(scriptActionOrder, scriptAction.Order)
+	data.Set(scriptActionActive, scriptAction.Active)
+	data.Set(commonProtectionPolicy, scriptAction.ProtectionPolicy)
+	data.Set(commonScope, scriptAction.Scope)
+}
+
+func resourceToScriptAction(data *schema.ResourceData) *client.ScriptAction {
+	scriptAction := client.ScriptAction{
+		Name:            data.Get(scriptActionName).(string),
+		EventName:       data.Get(scriptActionEventName).(string),
+		ConditionScript: data.Get(scriptActionCondition).(string),
+		Script:          data.Get(scriptActionScript).(string),
+		Order:           data.Get(scriptActionOrder).(int),
+		Active:          data.Get(scriptActionActive).(bool),
+	}
+	scriptAction.ID = data.Id()
+	scriptAction.ProtectionPolicy = data.Get(commonProtectionPolicy).(string)
+	scriptAction.Scope = data.Get(commonScope).(string)
+	return &scriptAction
+}
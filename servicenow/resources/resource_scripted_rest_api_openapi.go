@@ -0,0 +1,404 @@
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestAPIFromOpenAPIDocument = "document"
+const scriptedRestAPIFromOpenAPIScripts = "scripts"
+const scriptedRestAPIFromOpenAPIServiceID = "service_id"
+const scriptedRestAPIFromOpenAPIActive = "active"
+const scriptedRestAPIFromOpenAPIName = "name"
+const scriptedRestAPIFromOpenAPIBaseURI = "base_uri"
+const scriptedRestAPIFromOpenAPINamespace = "namespace"
+const scriptedRestAPIFromOpenAPIResourceIDs = "resource_ids"
+const scriptedRestAPIFromOpenAPIResourcesChecksum = "resources_checksum"
+
+// openAPISpec holds the parts of an OpenAPI document that map to a Scripted REST API.
+type openAPISpec struct {
+	Info struct {
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Paths map[string]map[string]json.RawMessage `json:"paths"`
+}
+
+type openAPISpecOperation struct {
+	OperationID string `json:"operationId"`
+	Summary     string `json:"summary"`
+	RequestBody struct {
+		Content map[string]json.RawMessage `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]json.RawMessage `json:"content"`
+	} `json:"responses"`
+}
+
+// ResourceScriptedRestAPIFromOpenAPI manages a Scripted REST API and its resources described by an OpenAPI document.
+func ResourceScriptedRestAPIFromOpenAPI() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptedRestAPIFromOpenAPI,
+		Read:   readResourceScriptedRestAPIFromOpenAPI,
+		Update: updateResourceScriptedRestAPIFromOpenAPI,
+		Delete: deleteResourceScriptedRestAPIFromOpenAPI,
+
+		CustomizeDiff: customdiff.All(validateScriptedRestAPIFromOpenAPIScripts, diffScriptedRestAPIFromOpenAPIResources),
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestAPIFromOpenAPIDocument: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The OpenAPI 3 document describing the API, as JSON. Every operation must have an 'operationId'.",
+			},
+			scriptedRestAPIFromOpenAPIScripts: {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The script implementing each operation, keyed by 'operationId'.",
+			},
+			scriptedRestAPIFromOpenAPIServiceID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The API identifier used to distinguish this API in URI paths. Must be unique within API namespace.",
+			},
+			scriptedRestAPIFromOpenAPIActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Activates the API. Inactive APIs cannot serve requests.",
+			},
+			scriptedRestAPIFromOpenAPIName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the API, taken from the title of the document.",
+			},
+			scriptedRestAPIFromOpenAPIBaseURI: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base API path (URI) to access this API.",
+			},
+			scriptedRestAPIFromOpenAPINamespace: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The namespace the API belongs to. The value depends on the current application scope.",
+			},
+			scriptedRestAPIFromOpenAPIResourceIDs: {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The sys_id of the resource created for each operation, keyed by 'operationId'.",
+			},
+			scriptedRestAPIFromOpenAPIResourcesChecksum: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "A checksum of the resources of the API, used to detect changes made on the instance.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptedRestAPIFromOpenAPI(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptedRestAPI := &client.ScriptedRestApi{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestApi, data.Id(), scriptedRestAPI); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	scriptedRestResources := []client.ScriptedRestResource{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointScriptedRestResource, "web_service_definition="+data.Id(), &scriptedRestResources); err != nil {
+		return err
+	}
+	resourceIDs := map[string]interface{}{}
+	for _, scriptedRestResource := range scriptedRestResources {
+		resourceIDs[scriptedRestResource.Name] = scriptedRestResource.ID
+	}
+
+	resourceFromScriptedRestAPIFromOpenAPI(data, scriptedRestAPI)
+	data.Set(scriptedRestAPIFromOpenAPIResourceIDs, resourceIDs)
+	data.Set(scriptedRestAPIFromOpenAPIResourcesChecksum, scriptedRestResourcesChecksum(scriptedRestResources))
+
+	return nil
+}
+
+func createResourceScriptedRestAPIFromOpenAPI(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptedRestAPI, scriptedRestResources, err := resourceToScriptedRestAPIFromOpenAPI(data)
+	if err != nil {
+		return err
+	}
+	if err := snowClient.CreateObject(client.EndpointScriptedRestApi, scriptedRestAPI); err != nil {
+		return err
+	}
+
+	resourceFromScriptedRestAPIFromOpenAPI(data, scriptedRestAPI)
+
+	if err := syncScriptedRestAPIResources(snowClient, data.Id(), scriptedRestResources); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestAPIFromOpenAPI(data, serviceNowClient)
+}
+
+func updateResourceScriptedRestAPIFromOpenAPI(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptedRestAPI, scriptedRestResources, err := resourceToScriptedRestAPIFromOpenAPI(data)
+	if err != nil {
+		return err
+	}
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestApi, scriptedRestAPI); err != nil {
+		return err
+	}
+
+	if err := syncScriptedRestAPIResources(snowClient, data.Id(), scriptedRestResources); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestAPIFromOpenAPI(data, serviceNowClient)
+}
+
+func deleteResourceScriptedRestAPIFromOpenAPI(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := syncScriptedRestAPIResources(snowClient, data.Id(), nil); err != nil {
+		return err
+	}
+	return snowClient.DeleteObject(client.EndpointScriptedRestApi, data.Id())
+}
+
+// syncScriptedRestAPIResources creates, updates and deletes the resources of an API to match the wanted ones, by name.
+func syncScriptedRestAPIResources(snowClient client.ServiceNowClient, apiID string, wanted []*client.ScriptedRestResource) error {
+	existing := []client.ScriptedRestResource{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointScriptedRestResource, "web_service_definition="+apiID, &existing); err != nil {
+		return err
+	}
+	existingIDs := map[string]string{}
+	for _, scriptedRestResource := range existing {
+		existingIDs[scriptedRestResource.Name] = scriptedRestResource.ID
+	}
+
+	for _, scriptedRestResource := range wanted {
+		scriptedRestResource.WebServiceDefinition = apiID
+		if id, ok := existingIDs[scriptedRestResource.Name]; ok {
+			scriptedRestResource.ID = id
+			delete(existingIDs, scriptedRestResource.Name)
+			if err := snowClient.UpdateObject(client.EndpointScriptedRestResource, scriptedRestResource); err != nil {
+				return err
+			}
+		} else if err := snowClient.CreateObject(client.EndpointScriptedRestResource, scriptedRestResource); err != nil {
+			return err
+		}
+	}
+
+	for _, id := range existingIDs {
+		if err := snowClient.DeleteObject(client.EndpointScriptedRestResource, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateScriptedRestAPIFromOpenAPIScripts(diff *schema.ResourceDiff, serviceNowClient interface{}) error {
+	if !diff.NewValueKnown(scriptedRestAPIFromOpenAPIDocument) || !diff.NewValueKnown(scriptedRestAPIFromOpenAPIScripts) {
+		return nil
+	}
+
+	_, operations, err := parseOpenAPISpec(diff.Get(scriptedRestAPIFromOpenAPIDocument).(string))
+	if err != nil {
+		return err
+	}
+	scripts := diff.Get(scriptedRestAPIFromOpenAPIScripts).(map[string]interface{})
+	for operationID := range operations {
+		if _, ok := scripts[operationID]; !ok {
+			return fmt.Errorf("%q has no script for operation %q", scriptedRestAPIFromOpenAPIScripts, operationID)
+		}
+	}
+	for operationID := range scripts {
+		if _, ok := operations[operationID]; !ok {
+			return fmt.Errorf("%q has a script for operation %q, which is not in the document", scriptedRestAPIFromOpenAPIScripts, operationID)
+		}
+	}
+	return nil
+}
+
+// diffScriptedRestAPIFromOpenAPIResources forces an update when the resources on the instance no longer
+// match the ones described by the document and scripts.
+func diffScriptedRestAPIFromOpenAPIResources(diff *schema.ResourceDiff, serviceNowClient interface{}) error {
+	if diff.Id() == "" || !diff.NewValueKnown(scriptedRestAPIFromOpenAPIDocument) || !diff.NewValueKnown(scriptedRestAPIFromOpenAPIScripts) {
+		return nil
+	}
+
+	_, operations, err := parseOpenAPISpec(diff.Get(scriptedRestAPIFromOpenAPIDocument).(string))
+	if err != nil {
+		return err
+	}
+	scripts := diff.Get(scriptedRestAPIFromOpenAPIScripts).(map[string]interface{})
+	wanted := make([]client.ScriptedRestResource, 0, len(operations))
+	for operationID, operation := range operations {
+		operation.OperationScript, _ = scripts[operationID].(string)
+		wanted = append(wanted, *operation)
+	}
+
+	if diff.Get(scriptedRestAPIFromOpenAPIResourcesChecksum).(string) != scriptedRestResourcesChecksum(wanted) {
+		return diff.SetNewComputed(scriptedRestAPIFromOpenAPIResourcesChecksum)
+	}
+	return nil
+}
+
+// scriptedRestResourcesChecksum hashes the fields of the resources that are managed from the document.
+func scriptedRestResourcesChecksum(scriptedRestResources []client.ScriptedRestResource) string {
+	lines := make([]string, 0, len(scriptedRestResources))
+	for _, scriptedRestResource := range scriptedRestResources {
+		produces, consumes := "", ""
+		if scriptedRestResource.ProducesCustomized {
+			produces = scriptedRestResource.Produces
+		}
+		if scriptedRestResource.ConsumesCustomized {
+			consumes = scriptedRestResource.Consumes
+		}
+		line, _ := json.Marshal([]interface{}{
+			scriptedRestResource.Name,
+			scriptedRestResource.Active,
+			scriptedRestResource.HTTPMethod,
+			scriptedRestResource.RelativePath,
+			scriptedRestResource.ShortDescription,
+			scriptedRestResource.OperationScript,
+			produces,
+			consumes,
+		})
+		lines = append(lines, string(line))
+	}
+	sort.Strings(lines)
+
+	checksum := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(checksum[:])
+}
+
+// parseOpenAPISpec parses an OpenAPI document into the resources it describes, keyed by operationId.
+// The scripts of the resources are left empty.
+func parseOpenAPISpec(document string) (*openAPISpec, map[string]*client.ScriptedRestResource, error) {
+	spec := &openAPISpec{}
+	if err := json.Unmarshal([]byte(document), spec); err != nil {
+		return nil, nil, fmt.Errorf("%q is not a valid OpenAPI JSON document: %s", scriptedRestAPIFromOpenAPIDocument, err)
+	}
+
+	scriptedRestResources := map[string]*client.ScriptedRestResource{}
+	for path, pathItem := range spec.Paths {
+		for method, rawOperation := range pathItem {
+			httpMethod := strings.ToUpper(method)
+			switch httpMethod {
+			case "GET", "POST", "PUT", "PATCH", "DELETE":
+			case "HEAD", "OPTIONS", "TRACE":
+				return nil, nil, fmt.Errorf("%s %s: scripted REST resources do not support the %s method", httpMethod, path, httpMethod)
+			default:
+				// Path-level fields such as 'parameters' or 'summary'.
+				continue
+			}
+
+			operation := &openAPISpecOperation{}
+			if err := json.Unmarshal(rawOperation, operation); err != nil {
+				return nil, nil, fmt.Errorf("%s %s: %s", httpMethod, path, err)
+			}
+			if operation.OperationID == "" {
+				return nil, nil, fmt.Errorf("%s %s: every operation must have an 'operationId'", httpMethod, path)
+			}
+			if _, ok := scriptedRestResources[operation.OperationID]; ok {
+				return nil, nil, fmt.Errorf("%s %s: duplicate operationId %q", httpMethod, path, operation.OperationID)
+			}
+
+			produces := []string{}
+			for code, response := range operation.Responses {
+				if strings.HasPrefix(code, "2") || code == "default" {
+					produces = append(produces, mediaTypes(response.Content)...)
+				}
+			}
+			consumes := mediaTypes(operation.RequestBody.Content)
+
+			scriptedRestResources[operation.OperationID] = &client.ScriptedRestResource{
+				Name:               operation.OperationID,
+				Active:             true,
+				HTTPMethod:         httpMethod,
+				RelativePath:       path,
+				ShortDescription:   operation.Summary,
+				Produces:           joinSortedUnique(produces),
+				ProducesCustomized: len(produces) > 0,
+				Consumes:           joinSortedUnique(consumes),
+				ConsumesCustomized: len(consumes) > 0,
+			}
+		}
+	}
+	return spec, scriptedRestResources, nil
+}
+
+func mediaTypes(content map[string]json.RawMessage) []string {
+	names := []string{}
+	for name := range content {
+		names = append(names, name)
+	}
+	return names
+}
+
+func joinSortedUnique(values []string) string {
+	sort.Strings(values)
+	unique := []string{}
+	for i, value := range values {
+		if i == 0 || values[i-1] != value {
+			unique = append(unique, value)
+		}
+	}
+	return strings.Join(unique, ",")
+}
+
+func resourceFromScriptedRestAPIFromOpenAPI(data *schema.ResourceData, scriptedRestAPI *client.ScriptedRestApi) {
+	data.SetId(scriptedRestAPI.ID)
+	data.Set(scriptedRestAPIFromOpenAPIServiceID, scriptedRestAPI.ServiceId)
+	data.Set(scriptedRestAPIFromOpenAPIActive, scriptedRestAPI.Active)
+	data.Set(scriptedRestAPIFromOpenAPIName, scriptedRestAPI.Name)
+	data.Set(scriptedRestAPIFromOpenAPIBaseURI, scriptedRestAPI.BaseURI)
+	data.Set(scriptedRestAPIFromOpenAPINamespace, scriptedRestAPI.Namespace)
+	data.Set(commonScope, scriptedRestAPI.Scope)
+}
+
+func resourceToScriptedRestAPIFromOpenAPI(data *schema.ResourceData) (*client.ScriptedRestApi, []*client.ScriptedRestResource, error) {
+	spec, operations, err := parseOpenAPISpec(data.Get(scriptedRestAPIFromOpenAPIDocument).(string))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scripts := data.Get(scriptedRestAPIFromOpenAPIScripts).(map[string]interface{})
+	operationIDs := make([]string, 0, len(operations))
+	for operationID := range operations {
+		operationIDs = append(operationIDs, operationID)
+	}
+	sort.Strings(operationIDs)
+
+	scriptedRestResources := make([]*client.ScriptedRestResource, 0, len(operationIDs))
+	for _, operationID := range operationIDs {
+		script, ok := scripts[operationID].(string)
+		if !ok {
+			return nil, nil, fmt.Errorf("%q has no script for operation %q", scriptedRestAPIFromOpenAPIScripts, operationID)
+		}
+		operations[operationID].OperationScript = script
+		scriptedRestResources = append(scriptedRestResources, operations[operationID])
+	}
+
+	scriptedRestAPI := client.ScriptedRestApi{
+		Name:             spec.Info.Title,
+		ShortDescription: spec.Info.Description,
+		ServiceId:        data.Get(scriptedRestAPIFromOpenAPIServiceID).(string),
+		Active:           data.Get(scriptedRestAPIFromOpenAPIActive).(bool),
+	}
+	scriptedRestAPI.ID = data.Id()
+	scriptedRestAPI.Scope = data.Get(commonScope).(string)
+	return &scriptedRestAPI, scriptedRestResources, nil
+}
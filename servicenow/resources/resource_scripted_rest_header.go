@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestHeaderName = "name"
+const scriptedRestHeaderShortDescription = "short_description"
+const scriptedRestHeaderRequired = "required"
+const scriptedRestHeaderExampleValue = "example_value"
+
+// ResourceScriptedRestHeader is holding the info about a request header accepted by Scripted REST resources.
+func ResourceScriptedRestHeader() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptedRestHeader,
+		Read:   readResourceScriptedRestHeader,
+		Update: updateResourceScriptedRestHeader,
+		Delete: deleteResourceScriptedRestHeader,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestHeaderName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the header.",
+			},
+			scriptedRestHeaderShortDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the header. Appears in API documentation.",
+			},
+			scriptedRestHeaderRequired: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether requests without this header are rejected.",
+			},
+			scriptedRestHeaderExampleValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "An example value. Appears in API documentation.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptedRestHeader(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	header := &client.ScriptedRestHeader{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestHeader, data.Id(), header); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScriptedRestHeader(data, header)
+
+	return nil
+}
+
+func createResourceScriptedRestHeader(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	header := resourceToScriptedRestHeader(data)
+	if err := snowClient.CreateObject(client.EndpointScriptedRestHeader, header); err != nil {
+		return err
+	}
+
+	resourceFromScriptedRestHeader(data, header)
+
+	return readResourceScriptedRestHeader(data, serviceNowClient)
+}
+
+func updateResourceScriptedRestHeader(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestHeader, resourceToScriptedRestHeader(data)); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestHeader(data, serviceNowClient)
+}
+
+func deleteResourceScriptedRestHeader(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScriptedRestHeader, data.Id())
+}
+
+func resourceFromScriptedRestHeader(data *schema.ResourceData, header *client.ScriptedRestHeader) {
+	data.SetId(header.ID)
+	data.Set(scriptedRestHeaderName, header.Name)
+	data.Set(scriptedRestHeaderShortDescription, header.ShortDescription)
+	data.Set(scriptedRestHeaderRequired, header.Required)
+	data.Set(scriptedRestHeaderExampleValue, header.ExampleValue)
+	data.Set(commonScope, header.Scope)
+}
+
+func resourceToScriptedRestHeader(data *schema.ResourceData) *client.ScriptedRestHeader {
+	header := client.ScriptedRestHeader{
+		Name:             data.Get(scriptedRestHeaderName).(string),
+		ShortDescription: data.Get(scriptedRestHeaderShortDescription).(string),
+		Required:         data.Get(scriptedRestHeaderRequired).(bool),
+		ExampleValue:     data.Get(scriptedRestHeaderExampleValue).(string),
+	}
+	header.ID = data.Id()
+	header.Scope = data.Get(commonScope).(string)
+	return &header
+}
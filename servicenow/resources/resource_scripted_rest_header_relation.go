@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestHeaderRelationResourceID = "resource_id"
+const scriptedRestHeaderRelationHeaderID = "header_id"
+
+// ResourceScriptedRestHeaderRelation is holding the relationship between a Scripted REST resource and a request header (many-2-many).
+func ResourceScriptedRestHeaderRelation() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptedRestHeaderRelation,
+		Read:   readResourceScriptedRestHeaderRelation,
+		Update: updateResourceScriptedRestHeaderRelation,
+		Delete: deleteResourceScriptedRestHeaderRelation,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestHeaderRelationResourceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Scripted REST resource accepting the header.",
+			},
+			scriptedRestHeaderRelationHeaderID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The request header accepted by the Scripted REST resource.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptedRestHeaderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relation := &client.ScriptedRestHeaderRelation{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestHeaderRelation, data.Id(), relation); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScriptedRestHeaderRelation(data, relation)
+
+	return nil
+}
+
+func createResourceScriptedRestHeaderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relation := resourceToScriptedRestHeaderRelation(data)
+	if err := snowClient.CreateObject(client.EndpointScriptedRestHeaderRelation, relation); err != nil {
+		return err
+	}
+
+	resourceFromScriptedRestHeaderRelation(data, relation)
+
+	return readResourceScriptedRestHeaderRelation(data, serviceNowClient)
+}
+
+func updateResourceScriptedRestHeaderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestHeaderRelation, resourceToScriptedRestHeaderRelation(data)); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestHeaderRelation(data, serviceNowClient)
+}
+
+func deleteResourceScriptedRestHeaderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScriptedRestHeaderRelation, data.Id())
+}
+
+func resourceFromScriptedRestHeaderRelation(data *schema.ResourceData, relation *client.ScriptedRestHeaderRelation) {
+	data.SetId(relation.ID)
+	data.Set(scriptedRestHeaderRelationResourceID, relation.ResourceID)
+	data.Set(scriptedRestHeaderRelationHeaderID, relation.HeaderID)
+	data.Set(commonScope, relation.Scope)
+}
+
+func resourceToScriptedRestHeaderRelation(data *schema.ResourceData) *client.ScriptedRestHeaderRelation {
+	relation := client.ScriptedRestHeaderRelation{
+		ResourceID: data.Get(scriptedRestHeaderRelationResourceID).(string),
+		HeaderID:   data.Get(scriptedRestHeaderRelationHeaderID).(string),
+	}
+	relation.ID = data.Id()
+	relation.Scope = data.Get(commonScope).(string)
+	return &relation
+}
@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestQueryParameterName = "name"
+const scriptedRestQueryParameterShortDescription = "short_description"
+const scriptedRestQueryParameterRequired = "required"
+const scriptedRestQueryParameterExampleValue = "example_value"
+
+// ResourceScriptedRestQueryParameter is holding the info about a query parameter accepted by Scripted REST resources.
+func ResourceScriptedRestQueryParameter() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptedRestQueryParameter,
+		Read:   readResourceScriptedRestQueryParameter,
+		Update: updateResourceScriptedRestQueryParameter,
+		Delete: deleteResourceScriptedRestQueryParameter,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestQueryParameterName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the query parameter.",
+			},
+			scriptedRestQueryParameterShortDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the query parameter. Appears in API documentation.",
+			},
+			scriptedRestQueryParameterRequired: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether requests without this query parameter are rejected.",
+			},
+			scriptedRestQueryParameterExampleValue: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "An example value. Appears in API documentation.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptedRestQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	queryParameter := &client.ScriptedRestQueryParameter{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestQueryParameter, data.Id(), queryParameter); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScriptedRestQueryParameter(data, queryParameter)
+
+	return nil
+}
+
+func createResourceScriptedRestQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	queryParameter := resourceToScriptedRestQueryParameter(data)
+	if err := snowClient.CreateObject(client.EndpointScriptedRestQueryParameter, queryParameter); err != nil {
+		return err
+	}
+
+	resourceFromScriptedRestQueryParameter(data, queryParameter)
+
+	return readResourceScriptedRestQueryParameter(data, serviceNowClient)
+}
+
+func updateResourceScriptedRestQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestQueryParameter, resourceToScriptedRestQueryParameter(data)); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestQueryParameter(data, serviceNowClient)
+}
+
+func deleteResourceScriptedRestQueryParameter(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScriptedRestQueryParameter, data.Id())
+}
+
+func resourceFromScriptedRestQueryParameter(data *schema.ResourceData, queryParameter *client.ScriptedRestQueryParameter) {
+	data.SetId(queryParameter.ID)
+	data.Set(scriptedRestQueryParameterName, queryParameter.Name)
+	data.Set(scriptedRestQueryParameterShortDescription, queryParameter.ShortDescription)
+	data.Set(scriptedRestQueryParameterRequired, queryParameter.Required)
+	data.Set(scriptedRestQueryParameterExampleValue, queryParameter.ExampleValue)
+	data.Set(commonScope, queryParameter.Scope)
+}
+
+func resourceToScriptedRestQueryParameter(data *schema.ResourceData) *client.ScriptedRestQueryParameter {
+	queryParameter := client.ScriptedRestQueryParameter{
+		Name:             data.Get(scriptedRestQueryParameterName).(string),
+		ShortDescription: data.Get(scriptedRestQueryParameterShortDescription).(string),
+		Required:         data.Get(scriptedRestQueryParameterRequired).(bool),
+		ExampleValue:     data.Get(scriptedRestQueryParameterExampleValue).(string),
+	}
+	queryParameter.ID = data.Id()
+	queryParameter.Scope = data.Get(commonScope).(string)
+	return &queryParameter
+}
@@ -0,0 +1,93 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestQueryParameterRelationResourceID = "resource_id"
+const scriptedRestQueryParameterRelationQueryParameterID = "query_parameter_id"
+
+// ResourceScriptedRestQueryParameterRelation is holding the relationship between a Scripted REST resource and a query parameter (many-2-many).
+func ResourceScriptedRestQueryParameterRelation() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptedRestQueryParameterRelation,
+		Read:   readResourceScriptedRestQueryParameterRelation,
+		Update: updateResourceScriptedRestQueryParameterRelation,
+		Delete: deleteResourceScriptedRestQueryParameterRelation,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestQueryParameterRelationResourceID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Scripted REST resource accepting the query parameter.",
+			},
+			scriptedRestQueryParameterRelationQueryParameterID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The query parameter accepted by the Scripted REST resource.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptedRestQueryParameterRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relation := &client.ScriptedRestQueryParameterRelation{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestQueryParameterRelation, data.Id(), relation); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScriptedRestQueryParameterRelation(data, relation)
+
+	return nil
+}
+
+func createResourceScriptedRestQueryParameterRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relation := resourceToScriptedRestQueryParameterRelation(data)
+	if err := snowClient.CreateObject(client.EndpointScriptedRestQueryParameterRelation, relation); err != nil {
+		return err
+	}
+
+	resourceFromScriptedRestQueryParameterRelation(data, relation)
+
+	return readResourceScriptedRestQueryParameterRelation(data, serviceNowClient)
+}
+
+func updateResourceScriptedRestQueryParameterRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestQueryParameterRelation, resourceToScriptedRestQueryParameterRelation(data)); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestQueryParameterRelation(data, serviceNowClient)
+}
+
+func deleteResourceScriptedRestQueryParameterRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScriptedRestQueryParameterRelation, data.Id())
+}
+
+func resourceFromScriptedRestQueryParameterRelation(data *schema.ResourceData, relation *client.ScriptedRestQueryParameterRelation) {
+	data.SetId(relation.ID)
+	data.Set(scriptedRestQueryParameterRelationResourceID, relation.ResourceID)
+	data.Set(scriptedRestQueryParameterRelationQueryParameterID, relation.QueryParameterID)
+	data.Set(commonScope, relation.Scope)
+}
+
+func resourceToScriptedRestQueryParameterRelation(data *schema.ResourceData) *client.ScriptedRestQueryParameterRelation {
+	relation := client.ScriptedRestQueryParameterRelation{
+		ResourceID:       data.Get(scriptedRestQueryParameterRelationResourceID).(string),
+		QueryParameterID: data.Get(scriptedRestQueryParameterRelationQueryParameterID).(string),
+	}
+	relation.ID = data.Id()
+	relation.Scope = data.Get(commonScope).(string)
+	return &relation
+}
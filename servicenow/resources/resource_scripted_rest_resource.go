@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -8,6 +11,7 @@ import (
 const scriptedRestResourceName = "name"
 const scriptedRestResourceActive = "active"
 const scriptedRestResourceEnforceACL = "enforce_acl"
+const scriptedRestResourceEnforceACLs = "enforce_acls"
 const scriptedRestResourceRequiresACLAuthorization = "requires_acl_authorization"
 const scriptedRestResourceRequiresAuthentication = "requires_authentication"
 const scriptedRestResourceRequiresSNCInternalRole = "requires_snc_internal_role"
@@ -66,9 +70,18 @@ func ResourceScriptedRestResource() *schema.Resource {
 				Description: "Activates the resource. Inactive resources cannot serve requests.",
 			},
 			scriptedRestResourceEnforceACL: {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The ACLs to enforce when accessing resources. Individual resources may override this value.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				Deprecated:    "Use 'enforce_acls' with the names of the ACLs instead.",
+				ConflictsWith: []string{scriptedRestResourceEnforceACLs},
+				Description:   "The comma-separated IDs of the ACLs to enforce when accessing this resource.",
+			},
+			scriptedRestResourceEnforceACLs: {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{scriptedRestResourceEnforceACL},
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Description:   "The names of the ACLs to enforce when accessing this resource. Overrides the ACLs of the parent API.",
 			},
 			scriptedRestResourceRequiresACLAuthorization: {
 				Type:        schema.TypeBool,
@@ -139,6 +152,17 @@ func readResourceScriptedRestResource(data *schema.ResourceData, serviceNowClien
 		return err
 	}
 
+	// Configurations still using the deprecated attribute keep the raw IDs.
+	if enforceACL, _ := data.Get(scriptedRestResourceEnforceACL).(string); enforceACL != "" {
+		data.Set(scriptedRestResourceEnforceACL, scriptedRestResource.EnforceACL)
+	} else {
+		aclNames, err := aclIDsToNames(snowClient, scriptedRestResource.EnforceACL)
+		if err != nil {
+			return err
+		}
+		data.Set(scriptedRestResourceEnforceACLs, aclNames)
+	}
+
 	resourceFromScriptedRestResource(data, scriptedRestResource)
 
 	return nil
@@ -147,6 +171,11 @@ func readResourceScriptedRestResource(data *schema.ResourceData, serviceNowClien
 func createResourceScriptedRestResource(data *schema.ResourceData, serviceNowClient interface{}) error {
 	snowClient := serviceNowClient.(client.ServiceNowClient)
 	scriptedRestResource := resourceToScriptedRestResource(data)
+	enforceACL, err := scriptedRestResourceACLIDs(data, snowClient)
+	if err != nil {
+		return err
+	}
+	scriptedRestResource.EnforceACL = enforceACL
 	if err := snowClient.CreateObject(client.EndpointScriptedRestResource, scriptedRestResource); err != nil {
 		return err
 	}
@@ -158,7 +187,13 @@ func createResourceScriptedRestResource(data *schema.ResourceData, serviceNowCli
 
 func updateResourceScriptedRestResource(data *schema.ResourceData, serviceNowClient interface{}) error {
 	snowClient := serviceNowClient.(client.ServiceNowClient)
-	if err := snowClient.UpdateObject(client.EndpointScriptedRestResource, resourceToScriptedRestResource(data)); err != nil {
+	scriptedRestResource := resourceToScriptedRestResource(data)
+	enforceACL, err := scriptedRestResourceACLIDs(data, snowClient)
+	if err != nil {
+		return err
+	}
+	scriptedRestResource.EnforceACL = enforceACL
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestResource, scriptedRestResource); err != nil {
 		return err
 	}
 
@@ -170,11 +205,55 @@ func deleteResourceScriptedRestResource(data *schema.ResourceData, serviceNowCli
 	return snowClient.DeleteObject(client.EndpointScriptedRestResource, data.Id())
 }
 
+// scriptedRestResourceACLIDs returns the IDs of the ACLs to enforce, from either the deprecated
+// attribute or the names of the ACLs.
+func scriptedRestResourceACLIDs(data *schema.ResourceData, snowClient client.ServiceNowClient) (string, error) {
+	if enforceACL := data.Get(scriptedRestResourceEnforceACL).(string); enforceACL != "" {
+		return enforceACL, nil
+	}
+	return aclNamesToIDs(snowClient, data.Get(scriptedRestResourceEnforceACLs).(*schema.Set))
+}
+
+// aclNamesToIDs resolves the names of ACLs to the comma-separated list of IDs stored by ServiceNow.
+func aclNamesToIDs(snowClient client.ServiceNowClient, names *schema.Set) (string, error) {
+	aclNames := make([]string, 0, names.Len())
+	for _, name := range names.List() {
+		aclNames = append(aclNames, name.(string))
+	}
+	sort.Strings(aclNames)
+
+	aclIDs := make([]string, 0, len(aclNames))
+	for _, name := range aclNames {
+		acl := &client.ACL{}
+		// ACL names are not unique across types and operations.
+		if err := snowClient.GetObjectByQuery(client.EndpointACL, "type=rest_endpoint^name="+name, acl); err != nil {
+			return "", err
+		}
+		aclIDs = append(aclIDs, acl.ID)
+	}
+	return strings.Join(aclIDs, ","), nil
+}
+
+// aclIDsToNames resolves a comma-separated list of ACL IDs to their names.
+func aclIDsToNames(snowClient client.ServiceNowClient, ids string) ([]string, error) {
+	aclNames := []string{}
+	for _, id := range strings.Split(ids, ",") {
+		if id == "" {
+			continue
+		}
+		acl := &client.ACL{}
+		if err := snowClient.GetObject(client.EndpointACL, id, acl); err != nil {
+			return nil, err
+		}
+		aclNames = append(aclNames, acl.Name)
+	}
+	return aclNames, nil
+}
+
 func resourceFromScriptedRestResource(data *schema.ResourceData, scriptedRestResource *client.ScriptedRestResource) {
 	data.SetId(scriptedRestResource.ID)
 	data.Set(scriptedRestResourceName, scriptedRestResource.Name)
 	data.Set(scriptedRestResourceActive, scriptedRestResource.Active)
-	data.Set(scriptedRestResourceEnforceACL, scriptedRestResource.EnforceACL)
 	data.Set(scriptedRestResourceRequiresACLAuthorization, scriptedRestResource.RequiresACLAuthorization)
 	data.Set(scriptedRestResourceRequiresAuthentication, scriptedRestResource.RequiresAuthentication)
 	data.Set(scriptedRestResourceRequiresSNCInternalRole, scriptedRestResource.RequiresSNCInternalRole)
@@ -196,7 +275,6 @@ func resourceToScriptedRestResource(data *schema.ResourceData) *client.ScriptedR
 	scriptedRestResource := client.ScriptedRestResource{
 		Name:                     data.Get(scriptedRestResourceName).(string),
 		Active:                   data.Get(scriptedRestResourceActive).(bool),
-		EnforceACL:               data.Get(scriptedRestResourceEnforceACL).(string),
 		RequiresACLAuthorization: data.Get(scriptedRestResourceRequiresACLAuthorization).(bool),
 		RequiresAuthentication:   data.Get(scriptedRestResourceRequiresAuthentication).(bool),
 		RequiresSNCInternalRole:  data.Get(scriptedRestResourceRequiresSNCInternalRole).(bool),
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const scriptedRestVersionWebServiceDefinition = "web_service_definition"
+const scriptedRestVersionVersion = "version"
+const scriptedRestVersionActive = "active"
+const scriptedRestVersionIsDefault = "is_default"
+const scriptedRestVersionDeprecated = "deprecated"
+const scriptedRestVersionShortDescription = "short_description"
+
+// ResourceScriptedRestVersion is holding the info about a version of a Scripted REST API.
+func ResourceScriptedRestVersion() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceScriptedRestVersion,
+		Read:   readResourceScriptedRestVersion,
+		Update: updateResourceScriptedRestVersion,
+		Delete: deleteResourceScriptedRestVersion,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			scriptedRestVersionWebServiceDefinition: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The parent API this version belongs to.",
+			},
+			scriptedRestVersionVersion: {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The version number, used in the URI of the API as '/v<version>'.",
+			},
+			scriptedRestVersionActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the resources of this version can serve requests.",
+			},
+			scriptedRestVersionIsDefault: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether requests without a version in the URI are served by this version.",
+			},
+			scriptedRestVersionDeprecated: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Flags the version as deprecated in the API documentation.",
+			},
+			scriptedRestVersionShortDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The description of the version. Appears in API documentation.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceScriptedRestVersion(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptedRestVersion := &client.ScriptedRestVersion{}
+	if err := snowClient.GetObject(client.EndpointScriptedRestVersion, data.Id(), scriptedRestVersion); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromScriptedRestVersion(data, scriptedRestVersion)
+
+	return nil
+}
+
+func createResourceScriptedRestVersion(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	scriptedRestVersion := resourceToScriptedRestVersion(data)
+	if err := snowClient.CreateObject(client.EndpointScriptedRestVersion, scriptedRestVersion); err != nil {
+		return err
+	}
+
+	resourceFromScriptedRestVersion(data, scriptedRestVersion)
+
+	return readResourceScriptedRestVersion(data, serviceNowClient)
+}
+
+func updateResourceScriptedRestVersion(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointScriptedRestVersion, resourceToScriptedRestVersion(data)); err != nil {
+		return err
+	}
+
+	return readResourceScriptedRestVersion(data, serviceNowClient)
+}
+
+func deleteResourceScriptedRestVersion(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointScriptedRestVersion, data.Id())
+}
+
+func resourceFromScriptedRestVersion(data *schema.ResourceData, scriptedRestVersion *client.ScriptedRestVersion) {
+	data.SetId(scriptedRestVersion.ID)
+	data.Set(scriptedRestVersionWebServiceDefinition, scriptedRestVersion.WebServiceDefinition)
+	data.Set(scriptedRestVersionVersion, scriptedRestVersion.Version)
+	data.Set(scriptedRestVersionActive, scriptedRestVersion.Active)
+	data.Set(scriptedRestVersionIsDefault, scriptedRestVersion.IsDefault)
+	data.Set(scriptedRestVersionDeprecated, scriptedRestVersion.Deprecated)
+	data.Set(scriptedRestVersionShortDescription, scriptedRestVersion.ShortDescription)
+	data.Set(commonScope, scriptedRestVersion.Scope)
+}
+
+func resourceToScriptedRestVersion(data *schema.ResourceData) *client.ScriptedRestVersion {
+	scriptedRestVersion := client.ScriptedRestVersion{
+		WebServiceDefinition: data.Get(scriptedRestVersionWebServiceDefinition).(string),
+		Version:              data.Get(scriptedRestVersionVersion).(int),
+		Active:               data.Get(scriptedRestVersionActive).(bool),
+		IsDefault:            data.Get(scriptedRestVersionIsDefault).(bool),
+		Deprecated:           data.Get(scriptedRestVersionDeprecated).(bool),
+		ShortDescription:     data.Get(scriptedRestVersionShortDescription).(string),
+	}
+	scriptedRestVersion.ID = data.Id()
+	scriptedRestVersion.Scope = data.Get(commonScope).(string)
+	return &scriptedRestVersion
+}
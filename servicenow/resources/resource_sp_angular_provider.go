@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const spAngularProviderName = "name"
+const spAngularProviderType = "type"
+const spAngularProviderScript = "script"
+
+// ResourceSPAngularProvider is holding the info about an Angular Provider usable by widgets.
+func ResourceSPAngularProvider() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceSPAngularProvider,
+		Read:   readResourceSPAngularProvider,
+		Update: updateResourceSPAngularProvider,
+		Delete: deleteResourceSPAngularProvider,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			spAngularProviderName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name under which the provider is injected in the widgets.",
+			},
+			spAngularProviderType: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "directive",
+				Description: "The type of Angular provider. Can be 'directive', 'service' or 'factory'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"directive", "service", "factory"})
+					return
+				},
+			},
+			spAngularProviderScript: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The client script defining the provider.",
+			},
+			commonProtectionPolicy: getProtectionPolicySchema(),
+			commonScope:            getScopeSchema(),
+		},
+	}
+}
+
+func readResourceSPAngularProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	angularProvider := &client.SPAngularProvider{}
+	if err := snowClient.GetObject(client.EndpointSPAngularProvider, data.Id(), angularProvider); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromSPAngularProvider(data, angularProvider)
+
+	return nil
+}
+
+func createResourceSPAngularProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	angularProvider := resourceToSPAngularProvider(data)
+	if err := snowClient.CreateObject(client.EndpointSPAngularProvider, angularProvider); err != nil {
+		return err
+	}
+
+	resourceFromSPAngularProvider(data, angularProvider)
+
+	return readResourceSPAngularProvider(data, serviceNowClient)
+}
+
+func updateResourceSPAngularProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointSPAngularProvider, resourceToSPAngularProvider(data)); err != nil {
+		return err
+	}
+
+	return readResourceSPAngularProvider(data, serviceNowClient)
+}
+
+func deleteResourceSPAngularProvider(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointSPAngularProvider, data.Id())
+}
+
+func resourceFromSPAngularProvider(data *schema.ResourceData, angularProvider *client.SPAngularProvider) {
+	data.SetId(angularProvider.ID)
+	data.Set(spAngularProviderName, angularProvider.Name)
+	data.Set(spAngularProviderType, angularProvider.Type)
+	data.Set(spAngularProviderScript, angularProvider.Script)
+	data.Set(commonProtectionPolicy, angularProvider.ProtectionPolicy)
+	data.Set(commonScope, angularProvider.Scope)
+}
+
+func resourceToSPAngularProvider(data *schema.ResourceData) *client.SPAngularProvider {
+	angularProvider := client.SPAngularProvider{
+		Name:   data.Get(spAngularProviderName).(string),
+		Type:   data.Get(spAngularProviderType).(string),
+		Script: data.Get(spAngularProviderScript).(string),
+	}
+	angularProvider.ID = data.Id()
+	angularProvider.ProtectionPolicy = data.Get(commonProtectionPolicy).(string)
+	angularProvider.Scope = data.Get(commonScope).(string)
+	return &angularProvider
+}
@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const spNgTemplateID = "identifier"
+const spNgTemplateWidgetID = "widget_id"
+const spNgTemplateTemplate = "template"
+
+// ResourceSPNgTemplate is holding the info about an Angular ng-template attached to a widget.
+func ResourceSPNgTemplate() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceSPNgTemplate,
+		Read:   readResourceSPNgTemplate,
+		Update: updateResourceSPNgTemplate,
+		Delete: deleteResourceSPNgTemplate,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			spNgTemplateID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID used to reference the template from the widget, ex: 'my-template.html'.",
+			},
+			spNgTemplateWidgetID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the widget using this template.",
+			},
+			spNgTemplateTemplate: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The HTML content of the template.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceSPNgTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	ngTemplate := &client.SPNgTemplate{}
+	if err := snowClient.GetObject(client.EndpointSPNgTemplate, data.Id(), ngTemplate); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromSPNgTemplate(data, ngTemplate)
+
+	return nil
+}
+
+func createResourceSPNgTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	ngTemplate := resourceToSPNgTemplate(data)
+	if err := snowClient.CreateObject(client.EndpointSPNgTemplate, ngTemplate); err != nil {
+		return err
+	}
+
+	resourceFromSPNgTemplate(data, ngTemplate)
+
+	return readResourceSPNgTemplate(data, serviceNowClient)
+}
+
+func updateResourceSPNgTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointSPNgTemplate, resourceToSPNgTemplate(data)); err != nil {
+		return err
+	}
+
+	return readResourceSPNgTemplate(data, serviceNowClient)
+}
+
+func deleteResourceSPNgTemplate(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointSPNgTemplate, data.Id())
+}
+
+func resourceFromSPNgTemplate(data *schema.ResourceData, ngTemplate *client.SPNgTemplate) {
+	data.SetId(ngTemplate.ID)
+	data.Set(spNgTemplateID, ngTemplate.CustomID)
+	data.Set(spNgTemplateWidgetID, ngTemplate.WidgetID)
+	data.Set(spNgTemplateTemplate, ngTemplate.Template)
+	data.Set(commonScope, ngTemplate.Scope)
+}
+
+func resourceToSPNgTemplate(data *schema.ResourceData) *client.SPNgTemplate {
+	ngTemplate := client.SPNgTemplate{
+		CustomID: data.Get(spNgTemplateID).(string),
+		WidgetID: data.Get(spNgTemplateWidgetID).(string),
+		Template: data.Get(spNgTemplateTemplate).(string),
+	}
+	ngTemplate.ID = data.Id()
+	ngTemplate.Scope = data.Get(commonScope).(string)
+	return &ngTemplate
+}
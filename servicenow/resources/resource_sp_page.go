@@ -0,0 +1,573 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const spPageID = "identifier"
+const spPageTitle = "title"
+const spPageShortDescription = "short_description"
+const spPagePublic = "public"
+const spPageRoles = "roles"
+const spPageCSS = "css"
+const spPageContainer = "container"
+const spPageContainerWidth = "width"
+const spPageContainerBackgroundColor = "background_color"
+const spPageRow = "row"
+const spPageColumn = "column"
+const spPageColumnSizeXS = "size_xs"
+const spPageColumnSizeSM = "size_sm"
+const spPageColumnSizeMD = "size_md"
+const spPageColumnSizeLG = "size_lg"
+const spPageInstance = "instance"
+const spPageInstanceID = "id"
+const spPageInstanceWidgetID = "widget_id"
+const spPageInstanceTitle = "title"
+const spPageInstanceOptions = "options"
+const spPageInstanceCSS = "css"
+const spPageInstanceRoles = "roles"
+const spPageCSSClass = "css_class"
+
+// ResourceSPPage manages a Service Portal Page in ServiceNow, along with its layout of containers, rows,
+// columns and widget instances. Layout records are matched by their position in the configuration.
+func ResourceSPPage() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceSPPage,
+		Read:   readResourceSPPage,
+		Update: updateResourceSPPage,
+		Delete: deleteResourceSPPage,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			spPageID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique identifier of the page, used in the URL of the portal with '?id=identifier'.",
+			},
+			spPageTitle: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Title of the page displayed in the browser.",
+			},
+			spPageShortDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Describe the purpose of the page.",
+			},
+			spPagePublic: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether unauthenticated users can access the page.",
+			},
+			spPageRoles: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Comma-separated list of Roles (names) that can access the page.",
+			},
+			spPageCSS: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "SCSS applied to the whole page.",
+			},
+			spPageContainer: {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The containers of the page, in display order.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						spPageContainerWidth: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "container",
+							Description: "Width of the container. Can be 'container' for a fixed width or 'container-fluid' for the full width.",
+							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+								warns, errs = validateStringValue(val.(string), key, []string{"container", "container-fluid"})
+								return
+							},
+						},
+						spPageContainerBackgroundColor: {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "",
+							Description: "Background color of the container.",
+						},
+						spPageCSSClass: getSPPageCSSClassSchema(),
+						spPageRow: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The rows of the container, in display order.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									spPageCSSClass: getSPPageCSSClassSchema(),
+									spPageColumn: {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "The columns of the row, in display order. Sizes are in bootstrap grid units, from 1 to 12.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												spPageColumnSizeXS: getSPPageColumnSizeSchema("Size of the column on extra small screens (phones)."),
+												spPageColumnSizeSM: getSPPageColumnSizeSchema("Size of the column on small screens (tablets)."),
+												spPageColumnSizeMD: {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Default:     12,
+													Description: "Size of the column on medium screens (desktops).",
+												},
+												spPageColumnSizeLG: getSPPageColumnSizeSchema("Size of the column on large screens."),
+												spPageCSSClass:     getSPPageCSSClassSchema(),
+												spPageInstance: {
+													Type:        schema.TypeList,
+													Optional:    true,
+													Description: "The widget instances placed in the column, in display order.",
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															spPageInstanceWidgetID: {
+																Type:        schema.TypeString,
+																Required:    true,
+																Description: "The widget record ID to display.",
+															},
+															spPageInstanceTitle: {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Default:     "",
+																Description: "Title of the widget instance.",
+															},
+															spPageInstanceOptions: {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Default:     "",
+																Description: "JSON object of the options passed to the widget, following its option schema.",
+															},
+															spPageInstanceCSS: {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Default:     "",
+																Description: "SCSS applied to this widget instance only.",
+															},
+															spPageInstanceRoles: {
+																Type:        schema.TypeString,
+																Optional:    true,
+																Default:     "",
+																Description: "Comma-separated list of Roles (names) that can view this widget instance.",
+															},
+															spPageCSSClass: getSPPageCSSClassSchema(),
+															spPageInstanceID: {
+																Type:        schema.TypeString,
+																Computed:    true,
+																Description: "The record ID of the widget instance.",
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			commonProtectionPolicy: getProtectionPolicySchema(),
+			commonScope:            getScopeSchema(),
+		},
+	}
+}
+
+func getSPPageCSSClassSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Default:     "",
+		Description: "CSS classes added to the element.",
+	}
+}
+
+func getSPPageColumnSizeSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Default:     0,
+		Description: description + " Uses the size of the smaller screen when 0.",
+	}
+}
+
+func readResourceSPPage(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spPage := &client.SPPage{}
+	if err := snowClient.GetObject(client.EndpointSPPage, data.Id(), spPage); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	containers, err := readSPPageContainers(snowClient, spPage.ID)
+	if err != nil {
+		return err
+	}
+
+	resourceFromSPPage(data, spPage)
+	data.Set(spPageContainer, containers)
+
+	return nil
+}
+
+func createResourceSPPage(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spPage := resourceToSPPage(data)
+	if err := snowClient.CreateObject(client.EndpointSPPage, spPage); err != nil {
+		return err
+	}
+
+	resourceFromSPPage(data, spPage)
+
+	if err := syncSPPageContainers(snowClient, data); err != nil {
+		return err
+	}
+
+	return readResourceSPPage(data, serviceNowClient)
+}
+
+func updateResourceSPPage(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointSPPage, resourceToSPPage(data)); err != nil {
+		return err
+	}
+
+	if data.HasChange(spPageContainer) {
+		if err := syncSPPageContainers(snowClient, data); err != nil {
+			return err
+		}
+	}
+
+	return readResourceSPPage(data, serviceNowClient)
+}
+
+func deleteResourceSPPage(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	containers := []client.SPContainer{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPContainer, "sp_page="+data.Id(), &containers); err != nil {
+		return err
+	}
+	for _, container := range containers {
+		if err := deleteSPContainer(snowClient, container.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointSPPage, data.Id())
+}
+
+func readSPPageContainers(snowClient client.ServiceNowClient, pageID string) ([]interface{}, error) {
+	containers := []client.SPContainer{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPContainer, "sp_page="+pageID+"^ORDERBYorder", &containers); err != nil {
+		return nil, err
+	}
+
+	containerList := make([]interface{}, 0, len(containers))
+	for _, container := range containers {
+		rows, err := readSPPageRows(snowClient, container.ID)
+		if err != nil {
+			return nil, err
+		}
+		containerList = append(containerList, map[string]interface{}{
+			spPageContainerWidth:           container.Width,
+			spPageContainerBackgroundColor: container.BackgroundColor,
+			spPageCSSClass:                 container.ClassName,
+			spPageRow:                      rows,
+		})
+	}
+	return containerList, nil
+}
+
+func readSPPageRows(snowClient client.ServiceNowClient, containerID string) ([]interface{}, error) {
+	rows := []client.SPRow{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPRow, "sp_container="+containerID+"^ORDERBYorder", &rows); err != nil {
+		return nil, err
+	}
+
+	rowList := make([]interface{}, 0, len(rows))
+	for _, row := range rows {
+		columns, err := readSPPageColumns(snowClient, row.ID)
+		if err != nil {
+			return nil, err
+		}
+		rowList = append(rowList, map[string]interface{}{
+			spPageCSSClass: row.ClassName,
+			spPageColumn:   columns,
+		})
+	}
+	return rowList, nil
+}
+
+func readSPPageColumns(snowClient client.ServiceNowClient, rowID string) ([]interface{}, error) {
+	columns := []client.SPColumn{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPColumn, "sp_row="+rowID+"^ORDERBYorder", &columns); err != nil {
+		return nil, err
+	}
+
+	columnList := make([]interface{}, 0, len(columns))
+	for _, column := range columns {
+		instances, err := readSPPageInstances(snowClient, column.ID)
+		if err != nil {
+			return nil, err
+		}
+		columnList = append(columnList, map[string]interface{}{
+			spPageColumnSizeXS: atoiOrZero(column.SizeXS),
+			spPageColumnSizeSM: atoiOrZero(column.SizeSM),
+			spPageColumnSizeMD: atoiOrZero(column.SizeMD),
+			spPageColumnSizeLG: atoiOrZero(column.SizeLG),
+			spPageCSSClass:     column.ClassName,
+			spPageInstance:     instances,
+		})
+	}
+	return columnList, nil
+}
+
+func readSPPageInstances(snowClient client.ServiceNowClient, columnID string) ([]interface{}, error) {
+	instances := []client.SPInstance{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPInstance, "sp_column="+columnID+"^ORDERBYorder", &instances); err != nil {
+		return nil, err
+	}
+
+	instanceList := make([]interface{}, 0, len(instances))
+	for _, instance := range instances {
+		instanceList = append(instanceList, map[string]interface{}{
+			spPageInstanceID:       instance.ID,
+			spPageInstanceWidgetID: instance.WidgetID,
+			spPageInstanceTitle:    instance.Title,
+			spPageInstanceOptions:  instance.Options,
+			spPageInstanceCSS:      instance.CSS,
+			spPageInstanceRoles:    instance.Roles,
+			spPageCSSClass:         instance.ClassName,
+		})
+	}
+	return instanceList, nil
+}
+
+// syncSPPageContainers saves the layout of the page. At every level, the existing records are updated
+// according to their position, missing ones are created and extra ones are deleted with their content.
+func syncSPPageContainers(snowClient client.ServiceNowClient, data *schema.ResourceData) error {
+	scope := data.Get(commonScope).(string)
+	existing := []client.SPContainer{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPContainer, "sp_page="+data.Id()+"^ORDERBYorder", &existing); err != nil {
+		return err
+	}
+
+	containerList := data.Get(spPageContainer).([]interface{})
+	for i, item := range containerList {
+		values := item.(map[string]interface{})
+		container := &client.SPContainer{
+			PageID:          data.Id(),
+			Order:           i,
+			Width:           values[spPageContainerWidth].(string),
+			BackgroundColor: values[spPageContainerBackgroundColor].(string),
+			ClassName:       values[spPageCSSClass].(string),
+		}
+		container.Scope = scope
+		if i < len(existing) {
+			container.ID = existing[i].ID
+			if err := snowClient.UpdateObject(client.EndpointSPContainer, container); err != nil {
+				return err
+			}
+		} else if err := snowClient.CreateObject(client.EndpointSPContainer, container); err != nil {
+			return err
+		}
+
+		if err := syncSPPageRows(snowClient, container.ID, values[spPageRow].([]interface{}), scope); err != nil {
+			return err
+		}
+	}
+
+	for i := len(containerList); i < len(existing); i++ {
+		if err := deleteSPContainer(snowClient, existing[i].ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func syncSPPageRows(snowClient client.ServiceNowClient, containerID string, rowList []interface{}, scope string) error {
+	existing := []client.SPRow{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPRow, "sp_container="+containerID+"^ORDERBYorder", &existing); err != nil {
+		return err
+	}
+
+	for i, item := range rowList {
+		values := item.(map[string]interface{})
+		row := &client.SPRow{
+			ContainerID: containerID,
+			Order:       i,
+			ClassName:   values[spPageCSSClass].(string),
+		}
+		row.Scope = scope
+		if i < len(existing) {
+			row.ID = existing[i].ID
+			if err := snowClient.UpdateObject(client.EndpointSPRow, row); err != nil {
+				return err
+			}
+		} else if err := snowClient.CreateObject(client.EndpointSPRow, row); err != nil {
+			return err
+		}
+
+		if err := syncSPPageColumns(snowClient, row.ID, values[spPageColumn].([]interface{}), scope); err != nil {
+			return err
+		}
+	}
+
+	for i := len(rowList); i < len(existing); i++ {
+		if err := deleteSPRow(snowClient, existing[i].ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func syncSPPageColumns(snowClient client.ServiceNowClient, rowID string, columnList []interface{}, scope string) error {
+	existing := []client.SPColumn{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPColumn, "sp_row="+rowID+"^ORDERBYorder", &existing); err != nil {
+		return err
+	}
+
+	for i, item := range columnList {
+		values := item.(map[string]interface{})
+		column := &client.SPColumn{
+			RowID:     rowID,
+			Order:     i,
+			SizeXS:    itoaOrEmpty(values[spPageColumnSizeXS].(int)),
+			SizeSM:    itoaOrEmpty(values[spPageColumnSizeSM].(int)),
+			SizeMD:    itoaOrEmpty(values[spPageColumnSizeMD].(int)),
+			SizeLG:    itoaOrEmpty(values[spPageColumnSizeLG].(int)),
+			ClassName: values[spPageCSSClass].(string),
+		}
+		column.Scope = scope
+		if i < len(existing) {
+			column.ID = existing[i].ID
+			if err := snowClient.UpdateObject(client.EndpointSPColumn, column); err != nil {
+				return err
+			}
+		} else if err := snowClient.CreateObject(client.EndpointSPColumn, column); err != nil {
+			return err
+		}
+
+		if err := syncSPPageInstances(snowClient, column.ID, values[spPageInstance].([]interface{}), scope); err != nil {
+			return err
+		}
+	}
+
+	for i := len(columnList); i < len(existing); i++ {
+		if err := deleteSPColumn(snowClient, existing[i].ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func syncSPPageInstances(snowClient client.ServiceNowClient, columnID string, instanceList []interface{}, scope string) error {
+	existing := []client.SPInstance{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPInstance, "sp_column="+columnID+"^ORDERBYorder", &existing); err != nil {
+		return err
+	}
+
+	for i, item := range instanceList {
+		values := item.(map[string]interface{})
+		instance := &client.SPInstance{
+			ColumnID:  columnID,
+			WidgetID:  values[spPageInstanceWidgetID].(string),
+			Order:     i,
+			Title:     values[spPageInstanceTitle].(string),
+			Options:   values[spPageInstanceOptions].(string),
+			CSS:       values[spPageInstanceCSS].(string),
+			Roles:     values[spPageInstanceRoles].(string),
+			ClassName: values[spPageCSSClass].(string),
+		}
+		instance.Scope = scope
+		if i < len(existing) {
+			instance.ID = existing[i].ID
+			if err := snowClient.UpdateObject(client.EndpointSPInstance, instance); err != nil {
+				return err
+			}
+		} else if err := snowClient.CreateObject(client.EndpointSPInstance, instance); err != nil {
+			return err
+		}
+	}
+
+	for i := len(instanceList); i < len(existing); i++ {
+		if err := snowClient.DeleteObject(client.EndpointSPInstance, existing[i].ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deleteSPContainer(snowClient client.ServiceNowClient, containerID string) error {
+	rows := []client.SPRow{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPRow, "sp_container="+containerID, &rows); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if err := deleteSPRow(snowClient, row.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointSPContainer, containerID)
+}
+
+func deleteSPRow(snowClient client.ServiceNowClient, rowID string) error {
+	columns := []client.SPColumn{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPColumn, "sp_row="+rowID, &columns); err != nil {
+		return err
+	}
+	for _, column := range columns {
+		if err := deleteSPColumn(snowClient, column.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointSPRow, rowID)
+}
+
+func deleteSPColumn(snowClient client.ServiceNowClient, columnID string) error {
+	instances := []client.SPInstance{}
+	if err := snowClient.GetObjectsByQuery(client.EndpointSPInstance, "sp_column="+columnID, &instances); err != nil {
+		return err
+	}
+	for _, instance := range instances {
+		if err := snowClient.DeleteObject(client.EndpointSPInstance, instance.ID); err != nil {
+			return err
+		}
+	}
+	return snowClient.DeleteObject(client.EndpointSPColumn, columnID)
+}
+
+func resourceFromSPPage(data *schema.ResourceData, spPage *client.SPPage) {
+	data.SetId(spPage.ID)
+	data.Set(spPageID, spPage.CustomID)
+	data.Set(spPageTitle, spPage.Title)
+	data.Set(spPageShortDescription, spPage.ShortDescription)
+	data.Set(spPagePublic, spPage.Public)
+	data.Set(spPageRoles, spPage.Roles)
+	data.Set(spPageCSS, spPage.CSS)
+	data.Set(commonProtectionPolicy, spPage.ProtectionPolicy)
+	data.Set(commonScope, spPage.Scope)
+}
+
+func resourceToSPPage(data *schema.ResourceData) *client.SPPage {
+	spPage := client.SPPage{
+		CustomID:         data.Get(spPageID).(string),
+		Title:            data.Get(spPageTitle).(string),
+		ShortDescription: data.Get(spPageShortDescription).(string),
+		Public:           data.Get(spPagePublic).(bool),
+		Roles:            data.Get(spPageRoles).(string),
+		CSS:              data.Get(spPageCSS).(string),
+	}
+	spPage.ID = data.Id()
+	spPage.ProtectionPolicy = data.Get(commonProtectionPolicy).(string)
+	spPage.Scope = data.Get(commonScope).(string)
+	return &spPage
+}
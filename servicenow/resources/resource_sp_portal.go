@@ -0,0 +1,164 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const spPortalTitle = "title"
+const spPortalURLSuffix = "url_suffix"
+const spPortalHomePageID = "homepage_id"
+const spPortalNotFoundPageID = "not_found_page_id"
+const spPortalLoginPageID = "login_page_id"
+const spPortalKBHomePageID = "kb_homepage_id"
+const spPortalCatalogHomePageID = "catalog_homepage_id"
+const spPortalThemeID = "theme_id"
+const spPortalLogo = "logo"
+const spPortalDefault = "default"
+
+// ResourceSPPortal is holding the info about a Service Portal.
+func ResourceSPPortal() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceSPPortal,
+		Read:   readResourceSPPortal,
+		Update: updateResourceSPPortal,
+		Delete: deleteResourceSPPortal,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			spPortalTitle: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			spPortalURLSuffix: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The suffix used in the URL to access the portal, ex: 'sp' for '/sp'.",
+			},
+			spPortalHomePageID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the page displayed when no page is specified.",
+			},
+			spPortalNotFoundPageID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the page displayed when the requested page does not exist.",
+			},
+			spPortalLoginPageID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the page displayed to unauthenticated users.",
+			},
+			spPortalKBHomePageID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the page used as the knowledge base home page.",
+			},
+			spPortalCatalogHomePageID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the page used as the service catalog home page.",
+			},
+			spPortalThemeID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the theme applied to the portal.",
+			},
+			spPortalLogo: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The name of the image displayed as the portal logo.",
+			},
+			spPortalDefault: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether this portal is the default one used by the instance.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceSPPortal(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spPortal := &client.SPPortal{}
+	if err := snowClient.GetObject(client.EndpointSPPortal, data.Id(), spPortal); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromSPPortal(data, spPortal)
+
+	return nil
+}
+
+func createResourceSPPortal(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spPortal := resourceToSPPortal(data)
+	if err := snowClient.CreateObject(client.EndpointSPPortal, spPortal); err != nil {
+		return err
+	}
+
+	resourceFromSPPortal(data, spPortal)
+
+	return readResourceSPPortal(data, serviceNowClient)
+}
+
+func updateResourceSPPortal(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointSPPortal, resourceToSPPortal(data)); err != nil {
+		return err
+	}
+
+	return readResourceSPPortal(data, serviceNowClient)
+}
+
+func deleteResourceSPPortal(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointSPPortal, data.Id())
+}
+
+func resourceFromSPPortal(data *schema.ResourceData, spPortal *client.SPPortal) {
+	data.SetId(spPortal.ID)
+	data.Set(spPortalTitle, spPortal.Title)
+	data.Set(spPortalURLSuffix, spPortal.URLSuffix)
+	data.Set(spPortalHomePageID, spPortal.HomePageID)
+	data.Set(spPortalNotFoundPageID, spPortal.NotFoundPageID)
+	data.Set(spPortalLoginPageID, spPortal.LoginPageID)
+	data.Set(spPortalKBHomePageID, spPortal.KBHomePageID)
+	data.Set(spPortalCatalogHomePageID, spPortal.CatalogHomePageID)
+	data.Set(spPortalThemeID, spPortal.ThemeID)
+	data.Set(spPortalLogo, spPortal.Logo)
+	data.Set(spPortalDefault, spPortal.Default)
+	data.Set(commonScope, spPortal.Scope)
+}
+
+func resourceToSPPortal(data *schema.ResourceData) *client.SPPortal {
+	spPortal := client.SPPortal{
+		Title:             data.Get(spPortalTitle).(string),
+		URLSuffix:         data.Get(spPortalURLSuffix).(string),
+		HomePageID:        data.Get(spPortalHomePageID).(string),
+		NotFoundPageID:    data.Get(spPortalNotFoundPageID).(string),
+		LoginPageID:       data.Get(spPortalLoginPageID).(string),
+		KBHomePageID:      data.Get(spPortalKBHomePageID).(string),
+		CatalogHomePageID: data.Get(spPortalCatalogHomePageID).(string),
+		ThemeID:           data.Get(spPortalThemeID).(string),
+		Logo:              data.Get(spPortalLogo).(string),
+		Default:           data.Get(spPortalDefault).(bool),
+	}
+	spPortal.ID = data.Id()
+	spPortal.Scope = data.Get(commonScope).(string)
+	return &spPortal
+}
@@ -0,0 +1,129 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const spThemeName = "name"
+const spThemeHeaderID = "header_id"
+const spThemeFooterID = "footer_id"
+const spThemeCSSVariables = "css_variables"
+const spThemeFixedHeader = "fixed_header"
+const spThemeFixedFooter = "fixed_footer"
+
+// ResourceSPTheme is holding the info about a Service Portal theme.
+func ResourceSPTheme() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceSPTheme,
+		Read:   readResourceSPTheme,
+		Update: updateResourceSPTheme,
+		Delete: deleteResourceSPTheme,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			spThemeName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			spThemeHeaderID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the widget used as the header of the portal.",
+			},
+			spThemeFooterID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The ID of the widget used as the footer of the portal.",
+			},
+			spThemeCSSVariables: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "SCSS variables available to all the style sheets of the theme.",
+			},
+			spThemeFixedHeader: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the header stays at the top of the page when scrolling.",
+			},
+			spThemeFixedFooter: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the footer stays at the bottom of the page when scrolling.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceSPTheme(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spTheme := &client.SPTheme{}
+	if err := snowClient.GetObject(client.EndpointSPTheme, data.Id(), spTheme); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromSPTheme(data, spTheme)
+
+	return nil
+}
+
+func createResourceSPTheme(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spTheme := resourceToSPTheme(data)
+	if err := snowClient.CreateObject(client.EndpointSPTheme, spTheme); err != nil {
+		return err
+	}
+
+	resourceFromSPTheme(data, spTheme)
+
+	return readResourceSPTheme(data, serviceNowClient)
+}
+
+func updateResourceSPTheme(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointSPTheme, resourceToSPTheme(data)); err != nil {
+		return err
+	}
+
+	return readResourceSPTheme(data, serviceNowClient)
+}
+
+func deleteResourceSPTheme(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointSPTheme, data.Id())
+}
+
+func resourceFromSPTheme(data *schema.ResourceData, spTheme *client.SPTheme) {
+	data.SetId(spTheme.ID)
+	data.Set(spThemeName, spTheme.Name)
+	data.Set(spThemeHeaderID, spTheme.HeaderID)
+	data.Set(spThemeFooterID, spTheme.FooterID)
+	data.Set(spThemeCSSVariables, spTheme.CSSVariables)
+	data.Set(spThemeFixedHeader, spTheme.FixedHeader)
+	data.Set(spThemeFixedFooter, spTheme.FixedFooter)
+	data.Set(commonScope, spTheme.Scope)
+}
+
+func resourceToSPTheme(data *schema.ResourceData) *client.SPTheme {
+	spTheme := client.SPTheme{
+		Name:         data.Get(spThemeName).(string),
+		HeaderID:     data.Get(spThemeHeaderID).(string),
+		FooterID:     data.Get(spThemeFooterID).(string),
+		CSSVariables: data.Get(spThemeCSSVariables).(string),
+		FixedHeader:  data.Get(spThemeFixedHeader).(bool),
+		FixedFooter:  data.Get(spThemeFixedFooter).(bool),
+	}
+	spTheme.ID = data.Id()
+	spTheme.Scope = data.Get(commonScope).(string)
+	return &spTheme
+}
@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const spThemeCSSIncludeThemeID = "theme_id"
+const spThemeCSSIncludeCSSIncludeID = "css_include_id"
+const spThemeCSSIncludeOrder = "order"
+
+// ResourceSPThemeCSSInclude is holding the info about the relation between a CSS Include and a Service Portal theme.
+func ResourceSPThemeCSSInclude() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceSPThemeCSSInclude,
+		Read:   readResourceSPThemeCSSInclude,
+		Update: updateResourceSPThemeCSSInclude,
+		Delete: deleteResourceSPThemeCSSInclude,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			spThemeCSSIncludeThemeID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			spThemeCSSIncludeCSSIncludeID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			spThemeCSSIncludeOrder: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The order in which the style sheets are loaded by the theme.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceSPThemeCSSInclude(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spThemeCSSInclude := &client.SPThemeCSSInclude{}
+	if err := snowClient.GetObject(client.EndpointSPThemeCSSInclude, data.Id(), spThemeCSSInclude); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromSPThemeCSSInclude(data, spThemeCSSInclude)
+
+	return nil
+}
+
+func createResourceSPThemeCSSInclude(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	spThemeCSSInclude := resourceToSPThemeCSSInclude(data)
+	if err := snowClient.CreateObject(client.EndpointSPThemeCSSInclude, spThemeCSSInclude); err != nil {
+		return err
+	}
+
+	resourceFromSPThemeCSSInclude(data, spThemeCSSInclude)
+
+	return readResourceSPThemeCSSInclude(data, serviceNowClient)
+}
+
+func updateResourceSPThemeCSSInclude(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointSPThemeCSSInclude, resourceToSPThemeCSSInclude(data)); err != nil {
+		return err
+	}
+
+	return readResourceSPThemeCSSInclude(data, serviceNowClient)
+}
+
+func deleteResourceSPThemeCSSInclude(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointSPThemeCSSInclude, data.Id())
+}
+
+func resourceFromSPThemeCSSInclude(data *schema.ResourceData, spThemeCSSInclude *client.SPThemeCSSInclude) {
+	data.SetId(spThemeCSSInclude.ID)
+	data.Set(spThemeCSSIncludeThemeID, spThemeCSSInclude.ThemeID)
+	data.Set(spThemeCSSIncludeCSSIncludeID, spThemeCSSInclude.CSSIncludeID)
+	data.Set(spThemeCSSIncludeOrder, spThemeCSSInclude.Order)
+	data.Set(commonScope, spThemeCSSInclude.Scope)
+}
+
+func resourceToSPThemeCSSInclude(data *schema.ResourceData) *client.SPThemeCSSInclude {
+	spThemeCSSInclude := client.SPThemeCSSInclude{
+		ThemeID:      data.Get(spThemeCSSIncludeThemeID).(string),
+		CSSIncludeID: data.Get(spThemeCSSIncludeCSSIncludeID).(string),
+		Order:        data.Get(spThemeCSSIncludeOrder).(int),
+	}
+	spThemeCSSInclude.ID = data.Id()
+	spThemeCSSInclude.Scope = data.Get(commonScope).(string)
+	return &spThemeCSSInclude
+}
@@ -0,0 +1,158 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const transformMapName = "name"
+const transformMapSourceTable = "source_table"
+const transformMapTargetTable = "target_table"
+const transformMapActive = "active"
+const transformMapRunBusinessRules = "run_business_rules"
+const transformMapEnforceMandatoryFields = "enforce_mandatory_fields"
+const transformMapCopyEmptyFields = "copy_empty_fields"
+const transformMapOrder = "order"
+const transformMapScript = "script"
+
+// ResourceTransformMap is holding the info about the mapping of an import set table to a target table.
+func ResourceTransformMap() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceTransformMap,
+		Read:   readResourceTransformMap,
+		Update: updateResourceTransformMap,
+		Delete: deleteResourceTransformMap,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			transformMapName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			transformMapSourceTable: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the import set table holding the imported rows.",
+			},
+			transformMapTargetTable: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the table receiving the transformed rows.",
+			},
+			transformMapActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			transformMapRunBusinessRules: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether business rules of the target table run when rows are transformed.",
+			},
+			transformMapEnforceMandatoryFields: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "No",
+				Description: "Whether rows missing mandatory values are rejected. Can be 'No', 'Only Mapped Fields' or 'All Fields'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"No", "Only Mapped Fields", "All Fields"})
+					return
+				},
+			},
+			transformMapCopyEmptyFields: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether empty source values clear the values of the target record.",
+			},
+			transformMapOrder: {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     100,
+				Description: "The order in which the transform maps of the same import set table are run.",
+			},
+			transformMapScript: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Script run for every row, before the field maps are applied. Use 'servicenow_transform_script' for other events.",
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceTransformMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	transformMap := &client.TransformMap{}
+	if err := snowClient.GetObject(client.EndpointTransformMap, data.Id(), transformMap); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromTransformMap(data, transformMap)
+
+	return nil
+}
+
+func createResourceTransformMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	transformMap := resourceToTransformMap(data)
+	if err := snowClient.CreateObject(client.EndpointTransformMap, transformMap); err != nil {
+		return err
+	}
+
+	resourceFromTransformMap(data, transformMap)
+
+	return readResourceTransformMap(data, serviceNowClient)
+}
+
+func updateResourceTransformMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointTransformMap, resourceToTransformMap(data)); err != nil {
+		return err
+	}
+
+	return readResourceTransformMap(data, serviceNowClient)
+}
+
+func deleteResourceTransformMap(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointTransformMap, data.Id())
+}
+
+func resourceFromTransformMap(data *schema.ResourceData, transformMap *client.TransformMap) {
+	data.SetId(transformMap.ID)
+	data.Set(transformMapName, transformMap.Name)
+	data.Set(transformMapSourceTable, transformMap.SourceTable)
+	data.Set(transformMapTargetTable, transformMap.TargetTable)
+	data.Set(transformMapActive, transformMap.Active)
+	data.Set(transformMapRunBusinessRules, transformMap.RunBusinessRules)
+	data.Set(transformMapEnforceMandatoryFields, transformMap.EnforceMandatoryFields)
+	data.Set(transformMapCopyEmptyFields, transformMap.CopyEmptyFields)
+	data.Set(transformMapOrder, transformMap.Order)
+	data.Set(transformMapScript, transformMap.Script)
+	data.Set(commonScope, transformMap.Scope)
+}
+
+func resourceToTransformMap(data *schema.ResourceData) *client.TransformMap {
+	transformMap := client.TransformMap{
+		Name:                   data.Get(transformMapName).(string),
+		SourceTable:            data.Get(transformMapSourceTable).(string),
+		TargetTable:            data.Get(transformMapTargetTable).(string),
+		Active:                 data.Get(transformMapActive).(bool),
+		RunBusinessRules:       data.Get(transformMapRunBusinessRules).(bool),
+		EnforceMandatoryFields: data.Get(transformMapEnforceMandatoryFields).(string),
+		CopyEmptyFields:        data.Get(transformMapCopyEmptyFields).(bool),
+		Order:                  data.Get(transformMapOrder).(int),
+		Script:                 data.Get(transformMapScript).(string),
+	}
+	transformMap.RunScript = transformMap.Script != ""
+	transformMap.ID = data.Id()
+	transformMap.Scope = data.Get(commonScope).(string)
+	return &transformMap
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const transformScriptTransformMapID = "transform_map_id"
+const transformScriptWhen = "when"
+const transformScriptOrder = "order"
+const transformScriptActive = "active"
+const transformScriptScript = "script"
+
+// ResourceTransformScript is holding the info about a script run at a specific step of a transform map.
+func ResourceTransformScript() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceTransformScript,
+		Read:   readResourceTransformScript,
+		Update: updateResourceTransformScript,
+		Delete: deleteResourceTransformScript,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			transformScriptTransformMapID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the transform map running the script.",
+			},
+			transformScriptWhen: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "When the script runs. Can be 'onStart', 'onBefore', 'onAfter', 'onComplete', 'onForeignInsert', 'onReject' or 'onChoiceCreate'.",
+				ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
+					warns, errs = validateStringValue(val.(string), key, []string{"onStart", "onBefore", "onAfter", "onComplete", "onForeignInsert", "onReject", "onChoiceCreate"})
+					return
+				},
+			},
+			transformScriptOrder: {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  100,
+			},
+			transformScriptActive: {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			transformScriptScript: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceTransformScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	transformScript := &client.TransformScript{}
+	if err := snowClient.GetObject(client.EndpointTransformScript, data.Id(), transformScript); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromTransformScript(data, transformScript)
+
+	return nil
+}
+
+func createResourceTransformScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	transformScript := resourceToTransformScript(data)
+	if err := snowClient.CreateObject(client.EndpointTransformScript, transformScript); err != nil {
+		return err
+	}
+
+	resourceFromTransformScript(data, transformScript)
+
+	return readResourceTransformScript(data, serviceNowClient)
+}
+
+func updateResourceTransformScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointTransformScript, resourceToTransformScript(data)); err != nil {
+		return err
+	}
+
+	return readResourceTransformScript(data, serviceNowClient)
+}
+
+func deleteResourceTransformScript(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointTransformScript, data.Id())
+}
+
+func resourceFromTransformScript(data *schema.ResourceData, transformScript *client.TransformScript) {
+	data.SetId(transformScript.ID)
+	data.Set(transformScriptTransformMapID, transformScript.TransformMapID)
+	data.Set(transformScriptWhen, transformScript.When)
+	data.Set(transformScriptOrder, transformScript.Order)
+	data.Set(transformScriptActive, transformScript.Active)
+	data.Set(transformScriptScript, transformScript.Script)
+	data.Set(commonScope, transformScript.Scope)
+}
+
+func resourceToTransformScript(data *schema.ResourceData) *client.TransformScript {
+	transformScript := client.TransformScript{
+		TransformMapID: data.Get(transformScriptTransformMapID).(string),
+		When:           data.Get(transformScriptWhen).(string),
+		Order:          data.Get(transformScriptOrder).(int),
+		Active:         data.Get(transformScriptActive).(bool),
+		Script:         data.Get(transformScriptScript).(string),
+	}
+	transformScript.ID = data.Id()
+	transformScript.Scope = data.Get(commonScope).(string)
+	return &transformScript
+}
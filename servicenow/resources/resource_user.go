@@ -0,0 +1,169 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const userUserName = "user_name"
+const userFirstName = "first_name"
+const userLastName = "last_name"
+const userName = "name"
+const userEmail = "email"
+const userTitle = "title"
+const userPassword = "password"
+const userPasswordNeedsReset = "password_needs_reset"
+const userWebServiceAccessOnly = "web_service_access_only"
+const userLockedOut = "locked_out"
+const userActive = "active"
+
+// ResourceUser manages a User in ServiceNow.
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceUser,
+		Read:   readResourceUser,
+		Update: updateResourceUser,
+		Delete: deleteResourceUser,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			userUserName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Unique login name of the user.",
+			},
+			userFirstName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "First name of the user.",
+			},
+			userLastName: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Last name of the user.",
+			},
+			userEmail: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Email address of the user.",
+			},
+			userTitle: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Job title of the user.",
+			},
+			userPassword: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Password of the user.",
+			},
+			userPasswordNeedsReset: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether the user must change its password on the next login.",
+			},
+			userWebServiceAccessOnly: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevents the user from logging in the UI. The user can only be used to make web service calls.",
+			},
+			userLockedOut: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Prevents the user from logging in.",
+			},
+			userActive: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether or not this user is enabled.",
+			},
+			userName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Full name of the user, built from the first and last names.",
+			},
+		},
+	}
+}
+
+func readResourceUser(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	user := &client.User{}
+	if err := snowClient.GetObject(client.EndpointUser, data.Id(), user); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromUser(data, user)
+
+	return nil
+}
+
+func createResourceUser(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	user := resourceToUser(data)
+	if err := snowClient.CreateObject(client.EndpointUser, user); err != nil {
+		return err
+	}
+
+	resourceFromUser(data, user)
+
+	return readResourceUser(data, serviceNowClient)
+}
+
+func updateResourceUser(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointUser, resourceToUser(data)); err != nil {
+		return err
+	}
+
+	return readResourceUser(data, serviceNowClient)
+}
+
+func deleteResourceUser(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointUser, data.Id())
+}
+
+func resourceFromUser(data *schema.ResourceData, user *client.User) {
+	data.SetId(user.ID)
+	data.Set(userUserName, user.UserName)
+	data.Set(userFirstName, user.FirstName)
+	data.Set(userLastName, user.LastName)
+	data.Set(userName, user.Name)
+	data.Set(userEmail, user.Email)
+	data.Set(userTitle, user.Title)
+	data.Set(userPasswordNeedsReset, user.PasswordNeedsReset)
+	data.Set(userWebServiceAccessOnly, user.WebServiceAccessOnly)
+	data.Set(userLockedOut, user.LockedOut)
+	data.Set(userActive, user.Active)
+}
+
+func resourceToUser(data *schema.ResourceData) *client.User {
+	user := client.User{
+		UserName:             data.Get(userUserName).(string),
+		FirstName:            data.Get(userFirstName).(string),
+		LastName:             data.Get(userLastName).(string),
+		Email:                data.Get(userEmail).(string),
+		Title:                data.Get(userTitle).(string),
+		PasswordNeedsReset:   data.Get(userPasswordNeedsReset).(bool),
+		WebServiceAccessOnly: data.Get(userWebServiceAccessOnly).(bool),
+		LockedOut:            data.Get(userLockedOut).(bool),
+		Active:               data.Get(userActive).(bool),
+	}
+	user.Password = getWriteOnlyValue(data, userPassword)
+	user.ID = data.Id()
+	return &user
+}
@@ -0,0 +1,90 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const userRoleUserID = "user_id"
+const userRoleRoleID = "role_id"
+
+// ResourceUserRole is holding a role granted directly to a user (many-2-many).
+func ResourceUserRole() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceUserRole,
+		Read:   readResourceUserRole,
+		Update: updateResourceUserRole,
+		Delete: deleteResourceUserRole,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			userRoleUserID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The user record ID receiving the role.",
+			},
+			userRoleRoleID: {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The role record ID granted to the user.",
+			},
+		},
+	}
+}
+
+func readResourceUserRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	userRole := &client.UserRole{}
+	if err := snowClient.GetObject(client.EndpointUserRole, data.Id(), userRole); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromUserRole(data, userRole)
+
+	return nil
+}
+
+func createResourceUserRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	userRole := resourceToUserRole(data)
+	if err := snowClient.CreateObject(client.EndpointUserRole, userRole); err != nil {
+		return err
+	}
+
+	resourceFromUserRole(data, userRole)
+
+	return readResourceUserRole(data, serviceNowClient)
+}
+
+func updateResourceUserRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointUserRole, resourceToUserRole(data)); err != nil {
+		return err
+	}
+
+	return readResourceUserRole(data, serviceNowClient)
+}
+
+func deleteResourceUserRole(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointUserRole, data.Id())
+}
+
+func resourceFromUserRole(data *schema.ResourceData, userRole *client.UserRole) {
+	data.SetId(userRole.ID)
+	data.Set(userRoleUserID, userRole.UserID)
+	data.Set(userRoleRoleID, userRole.RoleID)
+}
+
+func resourceToUserRole(data *schema.ResourceData) *client.UserRole {
+	userRole := client.UserRole{
+		UserID: data.Get(userRoleUserID).(string),
+		RoleID: data.Get(userRoleRoleID).(string),
+	}
+	userRole.ID = data.Id()
+	return &userRole
+}
@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const widgetAngularProviderRelationAngularProviderID = "angular_provider_id"
+const widgetAngularProviderRelationWidgetID = "widget_id"
+
+// ResourceWidgetAngularProviderRelation is holding the relationship between a widget and an Angular provider (many-2-many).
+func ResourceWidgetAngularProviderRelation() *schema.Resource {
+	return &schema.Resource{
+		Create: createResourceWidgetAngularProviderRelation,
+		Read:   readResourceWidgetAngularProviderRelation,
+		Update: updateResourceWidgetAngularProviderRelation,
+		Delete: deleteResourceWidgetAngularProviderRelation,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			widgetAngularProviderRelationAngularProviderID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			widgetAngularProviderRelationWidgetID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			commonScope: getScopeSchema(),
+		},
+	}
+}
+
+func readResourceWidgetAngularProviderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relation := &client.WidgetAngularProviderRelation{}
+	if err := snowClient.GetObject(client.EndpointWidgetAngularProviderRelation, data.Id(), relation); err != nil {
+		data.SetId("")
+		return err
+	}
+
+	resourceFromWidgetAngularProviderRelation(data, relation)
+
+	return nil
+}
+
+func createResourceWidgetAngularProviderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	relation := resourceToWidgetAngularProviderRelation(data)
+	if err := snowClient.CreateObject(client.EndpointWidgetAngularProviderRelation, relation); err != nil {
+		return err
+	}
+
+	resourceFromWidgetAngularProviderRelation(data, relation)
+
+	return readResourceWidgetAngularProviderRelation(data, serviceNowClient)
+}
+
+func updateResourceWidgetAngularProviderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	if err := snowClient.UpdateObject(client.EndpointWidgetAngularProviderRelation, resourceToWidgetAngularProviderRelation(data)); err != nil {
+		return err
+	}
+
+	return readResourceWidgetAngularProviderRelation(data, serviceNowClient)
+}
+
+func deleteResourceWidgetAngularProviderRelation(data *schema.ResourceData, serviceNowClient interface{}) error {
+	snowClient := serviceNowClient.(client.ServiceNowClient)
+	return snowClient.DeleteObject(client.EndpointWidgetAngularProviderRelation, data.Id())
+}
+
+func resourceFromWidgetAngularProviderRelation(data *schema.ResourceData, relation *client.WidgetAngularProviderRelation) {
+	data.SetId(relation.ID)
+	data.Set(widgetAngularProviderRelationAngularProviderID, relation.AngularProviderID)
+	data.Set(widgetAngularProviderRelationWidgetID, relation.WidgetID)
+	data.Set(commonScope, relation.Scope)
+}
+
+func resourceToWidgetAngularProviderRelation(data *schema.ResourceData) *client.WidgetAngularProviderRelation {
+	relation := client.WidgetAngularProviderRelation{
+		AngularProviderID: data.Get(widgetAngularProviderRelationAngularProviderID).(string),
+		WidgetID:          data.Get(widgetAngularProviderRelationWidgetID).(string),
+	}
+	relation.ID = data.Id()
+	relation.Scope = data.Get(commonScope).(string)
+	return &relation
+}
@@ -1,6 +1,7 @@
 package resources_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -9,6 +10,7 @@ import (
 	"github.com/coveooss/terraform-provider-servicenow/servicenow/client"
 	"github.com/coveooss/terraform-provider-servicenow/servicenow/resources"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 	"github.com/stretchr/testify/mock"
 )
 
@@ -26,6 +28,16 @@ func (m *ClientMock) GetObjectByName(endpoint string, name string, responseObjec
 	return args.Error(0)
 }
 
+func (m *ClientMock) GetObjectByQuery(endpoint string, query string, responseObjectOut client.Record) error {
+	args := m.Called(endpoint, query, responseObjectOut)
+	return args.Error(0)
+}
+
+func (m *ClientMock) GetObjectsByQuery(endpoint string, query string, responseObjectsOut interface{}) error {
+	args := m.Called(endpoint, query, responseObjectsOut)
+	return args.Error(0)
+}
+
 func (m *ClientMock) CreateObject(endpoint string, record client.Record) error {
 	args := m.Called(endpoint, record)
 	return args.Error(0)
@@ -65,33 +77,90 @@ func (m *RecordMock) GetError() string {
 	return args.String(0)
 }
 
+// resourceConfig builds a configuration from base attributes overridden by extra ones.
+func resourceConfig(base map[string]interface{}, extra map[string]interface{}) *terraform.ResourceConfig {
+	config := map[string]interface{}{}
+	for key, value := range base {
+		config[key] = value
+	}
+	for key, value := range extra {
+		config[key] = value
+	}
+	return terraform.NewResourceConfigRaw(config)
+}
+
 var resourcesToTest = []*schema.Resource{
 	resources.ResourceApplication(),
 	resources.ResourceApplicationMenu(),
 	resources.ResourceApplicationModule(),
+	resources.ResourceBasicAuthProfile(),
+	resources.ResourceCatalogCategory(),
+	resources.ResourceCatalogClientScript(),
+	resources.ResourceChoice(),
 	resources.ResourceContentCSS(),
 	resources.ResourceCSSInclude(),
 	resources.ResourceCSSIncludeRelation(),
+	resources.ResourceDataSource(),
+	resources.ResourceDBColumn(),
 	resources.ResourceDBTable(),
+	resources.ResourceDictionaryOverride(),
+	resources.ResourceEmailScript(),
+	resources.ResourceEmailTemplate(),
+	resources.ResourceEvent(),
 	resources.ResourceExtensionPoint(),
+	resources.ResourceFieldMap(),
+	resources.ResourceGroup(),
+	resources.ResourceGroupMember(),
+	resources.ResourceGroupRole(),
+	resources.ResourceInboundEmailAction(),
 	resources.ResourceJsInclude(),
 	resources.ResourceJsIncludeRelation(),
+	resources.ResourceJWTKeystoreAlias(),
+	resources.ResourceJWTProvider(),
+	resources.ResourceNotification(),
 	resources.ResourceOAuthEntity(),
+	resources.ResourceOAuthEntityProfile(),
+	resources.ResourceOAuthEntityScope(),
+	resources.ResourceProtocolProfile(),
+	resources.ResourceRecordProducer(),
+	resources.ResourceRelationship(),
 	resources.ResourceRole(),
+	resources.ResourceRestAPIAccessPolicy(),
 	resources.ResourceRestMessage(),
 	resources.ResourceRestMessageHeader(),
 	resources.ResourceRestMethod(),
 	resources.ResourceRestMethodHeader(),
+	resources.ResourceRestMethodParameter(),
+	resources.ResourceRestMethodQueryParameter(),
+	resources.ResourceRestRateLimit(),
+	resources.ResourceScheduledImport(),
+	resources.ResourceScheduledJob(),
+	resources.ResourceScriptAction(),
 	resources.ResourceScriptedRestApi(),
+	resources.ResourceScriptedRestHeader(),
+	resources.ResourceScriptedRestHeaderRelation(),
+	resources.ResourceScriptedRestQueryParameter(),
+	resources.ResourceScriptedRestQueryParameterRelation(),
 	resources.ResourceScriptedRestResource(),
+	resources.ResourceScriptedRestVersion(),
 	resources.ResourceScriptInclude(),
+	resources.ResourceSPAngularProvider(),
+	resources.ResourceSPNgTemplate(),
+	resources.ResourceSPPortal(),
+	resources.ResourceSPTheme(),
+	resources.ResourceSPThemeCSSInclude(),
 	resources.ResourceSystemProperty(),
 	resources.ResourceSystemPropertyCategory(),
 	resources.ResourceSystemPropertyRelation(),
+	resources.ResourceTransformMap(),
+	resources.ResourceTransformScript(),
 	resources.ResourceUIMacro(),
 	resources.ResourceUIPage(),
 	resources.ResourceUIScript(),
+	resources.ResourceUser(),
+	resources.ResourceUserRole(),
 	resources.ResourceWidget(),
+	resources.ResourceWidgetAngularProviderRelation(),
 	resources.ResourceWidgetDependency(),
 	resources.ResourceWidgetDependencyRelation(),
 }
@@ -101,6 +170,7 @@ var dataSourcesToTest = []*schema.Resource{
 	resources.DataSourceApplication(),
 	resources.DataSourceApplicationCategory(),
 	resources.DataSourceDBTable(),
+	resources.DataSourceGroup(),
 	resources.DataSourceRole(),
 	resources.DataSourceSystemProperty(),
 	resources.DataSourceSystemPropertyCategory(),
@@ -151,6 +221,21 @@ func TestDataSourcesCanRead(t *testing.T) {
 	}
 }
 
+func TestDataSourceUserCanRead(t *testing.T) {
+	res := resources.DataSourceUser()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"user_name": "oi",
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectByQuery", mock.AnythingOfType("string"), "user_name=oi", mock.Anything).
+		Return(nil)
+
+	res.Read(data, clientMock)
+	clientMock.AssertExpectations(t)
+}
+
 func TestResourcesCanUpdate(t *testing.T) {
 	for _, res := range resourcesToTest {
 		fakeData := map[string]interface{}{}
@@ -197,3 +282,933 @@ func TestResourcesCanDelete(t *testing.T) {
 		clientMock.AssertExpectations(t)
 	}
 }
+
+func TestResourceChoiceSetRemovesUnknownChoices(t *testing.T) {
+	res := resources.ResourceChoiceSet()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"table_name": "x_table",
+		"element":    "state",
+		"choice": []interface{}{
+			map[string]interface{}{"value": "keep", "label": "Keep"},
+			map[string]interface{}{"value": "new", "label": "New"},
+		},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointChoice, "name=x_table^element=state^language=en", mock.Anything).
+		Run(func(args mock.Arguments) {
+			choices := args.Get(2).(*[]client.Choice)
+			*choices = []client.Choice{
+				{BaseResult: client.BaseResult{ID: "1"}, Value: "keep"},
+				{BaseResult: client.BaseResult{ID: "2"}, Value: "old"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointChoice, mock.MatchedBy(func(choice *client.Choice) bool {
+			return choice.ID == "1" && choice.Label == "Keep"
+		})).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointChoice, mock.MatchedBy(func(choice *client.Choice) bool {
+			return choice.Value == "new" && choice.Label == "New"
+		})).
+		Return(nil)
+	clientMock.
+		On("DeleteObject", client.EndpointChoice, "2").
+		Return(nil)
+
+	res.Create(data, clientMock)
+	clientMock.AssertExpectations(t)
+	assert.Equal(t, "x_table.state", data.Id())
+}
+
+func TestResourceChoiceSetKeysDependentChoices(t *testing.T) {
+	res := resources.ResourceChoiceSet()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"table_name": "x_table",
+		"element":    "subcategory",
+		"choice": []interface{}{
+			map[string]interface{}{"value": "other", "label": "Other hardware", "dependent_value": "hardware"},
+			map[string]interface{}{"value": "other", "label": "Other software", "dependent_value": "software"},
+		},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointChoice, "name=x_table^element=subcategory^language=en", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.Choice) = []client.Choice{
+				{BaseResult: client.BaseResult{ID: "1"}, Value: "other", DependentValue: "hardware"},
+				{BaseResult: client.BaseResult{ID: "2"}, Value: "other", DependentValue: "hardware"},
+				{BaseResult: client.BaseResult{ID: "3"}, Value: "other", DependentValue: "network"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointChoice, mock.MatchedBy(func(choice *client.Choice) bool {
+			return choice.ID == "1" && choice.Label == "Other hardware"
+		})).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointChoice, mock.MatchedBy(func(choice *client.Choice) bool {
+			return choice.DependentValue == "software" && choice.Label == "Other software"
+		})).
+		Return(nil)
+	clientMock.On("DeleteObject", client.EndpointChoice, "2").Return(nil)
+	clientMock.On("DeleteObject", client.EndpointChoice, "3").Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceDBTableCreateResolvesExtendsAndAutoNumber(t *testing.T) {
+	res := resources.ResourceDBTable()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"label":     "My Table",
+		"user_role": "role",
+		"extends":   "task",
+		"auto_number": []interface{}{
+			map[string]interface{}{"prefix": "XAPP"},
+		},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectByName", client.EndpointDBTable, "task", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.DBTable).ID = "task_id"
+		}).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointDBTable, mock.MatchedBy(func(dbTable *client.DBTable) bool {
+			return dbTable.SuperClass == "task_id"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.DBTable).ID = "table_id"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointDBTable, "table_id", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.DBTable).Name = "x_table"
+		}).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointNumber, mock.MatchedBy(func(number *client.Number) bool {
+			return number.Category == "x_table" && number.Prefix == "XAPP" && number.Digits == 7 && number.Number == 1000
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.Number).ID = "number_id"
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointDBTable, mock.MatchedBy(func(dbTable *client.DBTable) bool {
+			return dbTable.ID == "table_id" && dbTable.NumberRef == "number_id"
+		})).
+		Return(nil)
+
+	res.Create(data, clientMock)
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceDBTableUpdateRemovesAutoNumber(t *testing.T) {
+	res := resources.ResourceDBTable()
+	base := map[string]interface{}{
+		"label":     "My Table",
+		"user_role": "role",
+	}
+	data := schema.TestResourceDataRaw(t, res.Schema, resourceConfig(base, map[string]interface{}{
+		"auto_number": []interface{}{
+			map[string]interface{}{"prefix": "XAPP"},
+		},
+	}).Raw)
+	data.SetId("table_id")
+	state := data.State()
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObject", client.EndpointDBTable, "table_id", mock.Anything).
+		Run(func(args mock.Arguments) {
+			dbTable := args.Get(2).(*client.DBTable)
+			dbTable.ID = "table_id"
+			dbTable.Name = "x_table"
+			dbTable.NumberRef = "number_id"
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointDBTable, mock.AnythingOfType("*client.DBTable")).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointDBTable, mock.MatchedBy(func(dbTable *client.DBTableNumberRef) bool {
+			return dbTable.ID == "table_id" && dbTable.NumberRef == ""
+		})).
+		Return(nil)
+	clientMock.
+		On("DeleteObject", client.EndpointNumber, "number_id").
+		Return(nil)
+	// The reference returned by the mock still points to the deleted record, which reads as no auto-number.
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointNumber, "sys_id=number_id", mock.Anything).
+		Return(nil)
+
+	diff, err := res.Diff(state, resourceConfig(base, nil), nil)
+	assert.NoError(t, err)
+	state, err = res.Apply(state, diff, clientMock)
+	assert.NoError(t, err)
+	clientMock.AssertExpectations(t)
+	assert.Equal(t, "0", state.Attributes["auto_number.#"])
+}
+
+func TestResourceDBViewDeletesJoinedTables(t *testing.T) {
+	res := resources.ResourceDBView()
+	data := schema.ResourceData{}
+	data.SetId("fenouille")
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointDBViewTable, "view=fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			viewTables := args.Get(2).(*[]client.DBViewTable)
+			*viewTables = []client.DBViewTable{
+				{BaseResult: client.BaseResult{ID: "1"}, VariablePrefix: "inc"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("DeleteObject", client.EndpointDBViewTable, "1").
+		Return(nil)
+	clientMock.
+		On("DeleteObject", client.EndpointDBView, "fenouille").
+		Return(nil)
+
+	res.Delete(&data, clientMock)
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceSPPageDeletesLayout(t *testing.T) {
+	res := resources.ResourceSPPage()
+	data := schema.ResourceData{}
+	data.SetId("fenouille")
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointSPContainer, "sp_page=fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.SPContainer) = []client.SPContainer{{BaseResult: client.BaseResult{ID: "container"}}}
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointSPRow, "sp_container=container", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.SPRow) = []client.SPRow{{BaseResult: client.BaseResult{ID: "row"}}}
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointSPColumn, "sp_row=row", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.SPColumn) = []client.SPColumn{{BaseResult: client.BaseResult{ID: "column"}}}
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointSPInstance, "sp_column=column", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.SPInstance) = []client.SPInstance{{BaseResult: client.BaseResult{ID: "instance"}}}
+		}).
+		Return(nil)
+	clientMock.On("DeleteObject", client.EndpointSPInstance, "instance").Return(nil)
+	clientMock.On("DeleteObject", client.EndpointSPColumn, "column").Return(nil)
+	clientMock.On("DeleteObject", client.EndpointSPRow, "row").Return(nil)
+	clientMock.On("DeleteObject", client.EndpointSPContainer, "container").Return(nil)
+	clientMock.On("DeleteObject", client.EndpointSPPage, "fenouille").Return(nil)
+
+	assert.NoError(t, res.Delete(&data, clientMock))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceSPPageSyncsLayout(t *testing.T) {
+	res := resources.ResourceSPPage()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"identifier": "fenouille",
+		"title":      "Fenouille",
+		"container": []interface{}{
+			map[string]interface{}{
+				"width": "container-fluid",
+				"row": []interface{}{
+					map[string]interface{}{
+						"column": []interface{}{
+							map[string]interface{}{
+								"size_md": 6,
+								"instance": []interface{}{
+									map[string]interface{}{"widget_id": "widget", "title": "Items"},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	})
+	data.SetId("fenouille")
+
+	clientMock := new(ClientMock)
+	clientMock.On("UpdateObject", client.EndpointSPPage, mock.Anything).Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointSPContainer, "sp_page=fenouille^ORDERBYorder", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.SPContainer) = []client.SPContainer{
+				{BaseResult: client.BaseResult{ID: "container"}},
+				{BaseResult: client.BaseResult{ID: "extra"}},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointSPContainer, mock.MatchedBy(func(container *client.SPContainer) bool {
+			return container.ID == "container" && container.PageID == "fenouille" && container.Width == "container-fluid"
+		})).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointSPRow, "sp_container=container^ORDERBYorder", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.SPRow) = []client.SPRow{{BaseResult: client.BaseResult{ID: "row"}}}
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointSPRow, mock.MatchedBy(func(row *client.SPRow) bool {
+			return row.ID == "row" && row.ContainerID == "container" && row.Order == 0
+		})).
+		Return(nil)
+	clientMock.On("GetObjectsByQuery", client.EndpointSPColumn, "sp_row=row^ORDERBYorder", mock.Anything).Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointSPColumn, mock.MatchedBy(func(column *client.SPColumn) bool {
+			return column.RowID == "row" && column.SizeMD == "6" && column.SizeXS == ""
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.SPColumn).ID = "column"
+		}).
+		Return(nil)
+	clientMock.On("GetObjectsByQuery", client.EndpointSPInstance, "sp_column=column^ORDERBYorder", mock.Anything).Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointSPInstance, mock.MatchedBy(func(instance *client.SPInstance) bool {
+			return instance.ColumnID == "column" && instance.WidgetID == "widget" && instance.Title == "Items"
+		})).
+		Return(nil)
+	clientMock.On("GetObjectsByQuery", client.EndpointSPRow, "sp_container=extra", mock.Anything).Return(nil)
+	clientMock.On("DeleteObject", client.EndpointSPContainer, "extra").Return(nil)
+	clientMock.On("GetObjectsByQuery", client.EndpointSPRow, "sp_container=extra^ORDERBYorder", mock.Anything).Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointSPPage, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.SPPage).ID = "fenouille"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Update(data, clientMock))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceCatalogVariableSyncsChoices(t *testing.T) {
+	res := resources.ResourceCatalogVariable()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"type": "select_box",
+		"choice": []interface{}{
+			map[string]interface{}{"value": "small", "text": "Small", "order": 1},
+			map[string]interface{}{"value": "large", "text": "Large", "order": 2},
+		},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointCatalogVariable, mock.MatchedBy(func(variable *client.CatalogVariable) bool {
+			return variable.Type == "5"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.CatalogVariable).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointCatalogVariableChoice, "question=fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.CatalogVariableChoice) = []client.CatalogVariableChoice{
+				{BaseResult: client.BaseResult{ID: "1"}, Value: "small"},
+				{BaseResult: client.BaseResult{ID: "2"}, Value: "medium"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointCatalogVariableChoice, mock.MatchedBy(func(choice *client.CatalogVariableChoice) bool {
+			return choice.ID == "1" && choice.Text == "Small"
+		})).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointCatalogVariableChoice, mock.MatchedBy(func(choice *client.CatalogVariableChoice) bool {
+			return choice.Value == "large" && choice.VariableID == "fenouille"
+		})).
+		Return(nil)
+	clientMock.
+		On("DeleteObject", client.EndpointCatalogVariableChoice, "2").
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointCatalogVariable, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.CatalogVariable).Type = "5"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	assert.Equal(t, "select_box", data.Get("type"))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceRecordProducerMapsFieldValuesToTemplate(t *testing.T) {
+	res := resources.ResourceRecordProducer()
+	data := res.TestResourceData()
+	data.Set("field_values", map[string]interface{}{"priority": "2", "category": "software"})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointRecordProducer, mock.MatchedBy(func(recordProducer *client.RecordProducer) bool {
+			return recordProducer.Template == "category=software^priority=2^EQ"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.RecordProducer).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointRecordProducer, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.RecordProducer).Template = "category=hardware^EQ"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	assert.Equal(t, map[string]interface{}{"category": "hardware"}, data.Get("field_values"))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceCatalogItemSyncsAdditionalCategories(t *testing.T) {
+	res := resources.ResourceCatalogItem()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                    "Laptop",
+		"catalog_ids":             "catalog",
+		"category_id":             "hardware",
+		"additional_category_ids": []interface{}{"computers", "popular"},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointCatalogItem, mock.MatchedBy(func(catalogItem *client.CatalogItem) bool {
+			return catalogItem.CategoryID == "hardware"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.CatalogItem).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointCatalogItemCategory, "sc_cat_item=fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.CatalogItemCategory) = []client.CatalogItemCategory{
+				{BaseResult: client.BaseResult{ID: "1"}, CategoryID: "computers"},
+				{BaseResult: client.BaseResult{ID: "2"}, CategoryID: "retired"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointCatalogItemCategory, mock.MatchedBy(func(itemCategory *client.CatalogItemCategory) bool {
+			return itemCategory.CatalogItemID == "fenouille" && itemCategory.CategoryID == "popular"
+		})).
+		Return(nil)
+	clientMock.On("DeleteObject", client.EndpointCatalogItemCategory, "2").Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointCatalogItem, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.CatalogItem).ID = "fenouille"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceCatalogUIPolicyDeletesActions(t *testing.T) {
+	res := resources.ResourceCatalogUIPolicy()
+	data := schema.ResourceData{}
+	data.SetId("fenouille")
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointCatalogUIPolicyAction, "ui_policy=fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.CatalogUIPolicyAction) = []client.CatalogUIPolicyAction{
+				{BaseResult: client.BaseResult{ID: "1"}, Variable: "IO:variable"},
+			}
+		}).
+		Return(nil)
+	clientMock.On("DeleteObject", client.EndpointCatalogUIPolicyAction, "1").Return(nil)
+	clientMock.On("DeleteObject", client.EndpointCatalogUIPolicy, "fenouille").Return(nil)
+
+	assert.NoError(t, res.Delete(&data, clientMock))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceDataSourceSendsJDBCConnection(t *testing.T) {
+	res := resources.ResourceDataSource()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"type":     "JDBC",
+		"server":   "db.example.com",
+		"username": "reader",
+		"password": "secret",
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointDataSource, mock.MatchedBy(func(dataSource *client.DataSource) bool {
+			return dataSource.JDBCServer == "db.example.com" && dataSource.JDBCUserName == "reader" &&
+				dataSource.JDBCPassword == "secret" && dataSource.Server == "" && dataSource.Password == ""
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.DataSource).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointDataSource, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			dataSource := args.Get(2).(*client.DataSource)
+			dataSource.ID = "fenouille"
+			dataSource.Scope = "global"
+			dataSource.Type = "JDBC"
+			dataSource.JDBCServer = "db.example.com"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	assert.Equal(t, "db.example.com", data.Get("server"))
+	assert.Equal(t, "secret", data.Get("password"))
+	clientMock.AssertExpectations(t)
+
+	// Switching to a file keeps the password, which must be moved to the other column.
+	state := data.State()
+	diff, err := res.Diff(state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"type":     "File",
+		"server":   "db.example.com",
+		"username": "reader",
+		"password": "secret",
+	}), nil)
+	assert.NoError(t, err)
+
+	clientMock = new(ClientMock)
+	clientMock.
+		On("UpdateObject", client.EndpointDataSource, mock.MatchedBy(func(dataSource *client.DataSource) bool {
+			return dataSource.Server == "db.example.com" && dataSource.Password == "secret" && dataSource.JDBCPassword == ""
+		})).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointDataSource, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			dataSource := args.Get(2).(*client.DataSource)
+			dataSource.ID = "fenouille"
+			dataSource.Type = "File"
+			dataSource.Server = "db.example.com"
+		}).
+		Return(nil)
+
+	_, err = res.Apply(state, diff, clientMock)
+	assert.NoError(t, err)
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceScriptedRestResourceResolvesACLNames(t *testing.T) {
+	res := resources.ResourceScriptedRestResource()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                   "items",
+		"http_method":            "GET",
+		"operation_script":       "response.setBody({});",
+		"web_service_definition": "api",
+		"enforce_acls":           []interface{}{"Scripted REST External Default", "Items Reader"},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObjectByQuery", client.EndpointACL, "type=rest_endpoint^name=Items Reader", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ACL).ID = "1"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectByQuery", client.EndpointACL, "type=rest_endpoint^name=Scripted REST External Default", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ACL).ID = "2"
+		}).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointScriptedRestResource, mock.MatchedBy(func(resource *client.ScriptedRestResource) bool {
+			return resource.EnforceACL == "1,2"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.ScriptedRestResource).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointScriptedRestResource, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ScriptedRestResource).EnforceACL = "1,2"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointACL, "1", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ACL).Name = "Items Reader"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointACL, "2", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ACL).Name = "Scripted REST External Default"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	assert.Equal(t, 2, data.Get("enforce_acls").(*schema.Set).Len())
+	assert.True(t, data.Get("enforce_acls").(*schema.Set).Contains("Items Reader"))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceScriptedRestResourceKeepsDeprecatedACLIDs(t *testing.T) {
+	res := resources.ResourceScriptedRestResource()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name":                   "items",
+		"http_method":            "GET",
+		"operation_script":       "response.setBody({});",
+		"web_service_definition": "api",
+		"enforce_acl":            "1,2",
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointScriptedRestResource, mock.MatchedBy(func(resource *client.ScriptedRestResource) bool {
+			return resource.EnforceACL == "1,2"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.ScriptedRestResource).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointScriptedRestResource, "fenouille", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ScriptedRestResource).EnforceACL = "1,2"
+		}).
+		Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	assert.Equal(t, "1,2", data.Get("enforce_acl"))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceOAuthEntityGeneratesClientSecret(t *testing.T) {
+	res := resources.ResourceOAuthEntity()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"name": "My App",
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointOAuthEntity, mock.MatchedBy(func(oauthEntity *client.OAuthEntity) bool {
+			return len(oauthEntity.ClientSecret) == 32
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.OAuthEntity).ID = "fenouille"
+		}).
+		Return(nil)
+	clientMock.On("GetObject", client.EndpointOAuthEntity, "fenouille", mock.Anything).Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	assert.Len(t, data.Get("client_secret"), 32)
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceRestMessageValidatesAuthenticationProfile(t *testing.T) {
+	res := resources.ResourceRestMessage()
+	base := map[string]interface{}{
+		"name":          "My Message",
+		"rest_endpoint": "https://example.com",
+	}
+
+	_, err := res.Diff(nil, resourceConfig(base, map[string]interface{}{"authentication_type": "basic"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"authentication_type": "basic", "oauth_profile_id": "profile"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"basic_auth_profile_id": "profile"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"authentication_type": "basic", "basic_auth_profile_id": "profile"}), nil)
+	assert.NoError(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"authentication_type": "oauth2", "oauth_profile_id": "profile"}), nil)
+	assert.NoError(t, err)
+}
+
+func TestResourceNotificationValidatesSendWhen(t *testing.T) {
+	res := resources.ResourceNotification()
+	base := map[string]interface{}{
+		"name":  "Incident assigned",
+		"table": "incident",
+	}
+
+	_, err := res.Diff(nil, resourceConfig(base, nil), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"send_when": "event"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"inserted": true}), nil)
+	assert.NoError(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"send_when": "event", "event_name": "incident.assigned"}), nil)
+	assert.NoError(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"send_when": "triggered"}), nil)
+	assert.NoError(t, err)
+}
+
+func TestResourceRestMethodValidatesConnection(t *testing.T) {
+	res := resources.ResourceRestMethod()
+	base := map[string]interface{}{
+		"name":            "get",
+		"rest_message_id": "message",
+		"http_method":     "get",
+	}
+
+	_, err := res.Diff(nil, resourceConfig(base, map[string]interface{}{"use_mutual_auth": true}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"protocol_profile_id": "profile"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"mid_server_id": "mid"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"use_mutual_auth": true, "protocol_profile_id": "profile", "use_mid_server": true, "mid_server_id": "mid"}), nil)
+	assert.NoError(t, err)
+}
+
+func TestDataSourceScriptedRestAPIOpenAPIDescribesResources(t *testing.T) {
+	res := resources.DataSourceScriptedRestAPIOpenAPI()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"api_id": "api",
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("GetObject", client.EndpointScriptedRestApi, "api", mock.Anything).
+		Run(func(args mock.Arguments) {
+			api := args.Get(2).(*client.ScriptedRestApi)
+			api.ID = "api"
+			api.Name = "Items"
+			api.BaseURI = "/api/x_ns/items"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointScriptedRestVersion, "web_service_definition=api", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.ScriptedRestVersion) = []client.ScriptedRestVersion{
+				{BaseResult: client.BaseResult{ID: "v1"}, Version: 1, IsDefault: true},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointScriptedRestResource, "web_service_definition=api^active=true", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.ScriptedRestResource) = []client.ScriptedRestResource{
+				{BaseResult: client.BaseResult{ID: "get"}, Name: "getItem", HTTPMethod: "GET", RelativePath: "/{id}", OperationURI: "/api/x_ns/v1/items/{id}", WebServiceVersion: "v1"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointScriptedRestQueryParameterRelation, "web_service_operation=get", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.ScriptedRestQueryParameterRelation) = []client.ScriptedRestQueryParameterRelation{
+				{QueryParameterID: "fields"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointScriptedRestQueryParameter, "fields", mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(2).(*client.ScriptedRestQueryParameter).Name = "fields"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointScriptedRestHeaderRelation, "web_service_operation=get", mock.Anything).
+		Return(nil)
+
+	assert.NoError(t, res.Read(data, clientMock))
+
+	document := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(data.Get("document").(string)), &document))
+	assert.Equal(t, "v1", document["info"].(map[string]interface{})["version"])
+	operation := document["paths"].(map[string]interface{})["/api/x_ns/v1/items/{id}"].(map[string]interface{})["get"].(map[string]interface{})
+	assert.Equal(t, "getItem", operation["operationId"])
+	assert.Len(t, operation["parameters"], 2)
+	clientMock.AssertExpectations(t)
+}
+
+const itemsOpenAPIDocument = `{
+  "openapi": "3.0.3",
+  "info": {"title": "Items", "version": "1.0.0"},
+  "paths": {
+    "/items": {
+      "get": {"operationId": "listItems", "summary": "List items", "responses": {"200": {"content": {"application/json": {}}}}},
+      "post": {"operationId": "createItem", "requestBody": {"content": {"application/json": {}}}, "responses": {"201": {"content": {"application/json": {}}}}}
+    }
+  }
+}`
+
+func TestResourceScriptedRestAPIFromOpenAPISyncsResources(t *testing.T) {
+	res := resources.ResourceScriptedRestAPIFromOpenAPI()
+	data := schema.TestResourceDataRaw(t, res.Schema, map[string]interface{}{
+		"document": itemsOpenAPIDocument,
+		"scripts": map[string]interface{}{
+			"listItems":  "list();",
+			"createItem": "create();",
+		},
+	})
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointScriptedRestApi, mock.MatchedBy(func(api *client.ScriptedRestApi) bool {
+			return api.Name == "Items"
+		})).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.ScriptedRestApi).ID = "api"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointScriptedRestResource, "web_service_definition=api", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.ScriptedRestResource) = []client.ScriptedRestResource{
+				{BaseResult: client.BaseResult{ID: "1"}, Name: "listItems"},
+				{BaseResult: client.BaseResult{ID: "2"}, Name: "deleteItem"},
+			}
+		}).
+		Return(nil)
+	clientMock.
+		On("UpdateObject", client.EndpointScriptedRestResource, mock.MatchedBy(func(resource *client.ScriptedRestResource) bool {
+			return resource.ID == "1" && resource.HTTPMethod == "GET" && resource.RelativePath == "/items" &&
+				resource.OperationScript == "list();" && resource.Produces == "application/json" && resource.WebServiceDefinition == "api"
+		})).
+		Return(nil)
+	clientMock.
+		On("CreateObject", client.EndpointScriptedRestResource, mock.MatchedBy(func(resource *client.ScriptedRestResource) bool {
+			return resource.Name == "createItem" && resource.HTTPMethod == "POST" && resource.Consumes == "application/json"
+		})).
+		Return(nil)
+	clientMock.On("DeleteObject", client.EndpointScriptedRestResource, "2").Return(nil)
+	clientMock.On("GetObject", client.EndpointScriptedRestApi, "api", mock.Anything).Return(nil)
+
+	assert.NoError(t, res.Create(data, clientMock))
+	clientMock.AssertExpectations(t)
+}
+
+func TestResourceScriptedRestAPIFromOpenAPIDetectsChangedResources(t *testing.T) {
+	res := resources.ResourceScriptedRestAPIFromOpenAPI()
+	config := map[string]interface{}{
+		"document": itemsOpenAPIDocument,
+		"scripts": map[string]interface{}{
+			"listItems":  "list();",
+			"createItem": "create();",
+		},
+	}
+	data := schema.TestResourceDataRaw(t, res.Schema, config)
+
+	clientMock := new(ClientMock)
+	clientMock.
+		On("CreateObject", client.EndpointScriptedRestApi, mock.Anything).
+		Run(func(args mock.Arguments) {
+			args.Get(1).(*client.ScriptedRestApi).ID = "api"
+		}).
+		Return(nil)
+	clientMock.
+		On("GetObjectsByQuery", client.EndpointScriptedRestResource, "web_service_definition=api", mock.Anything).
+		Run(func(args mock.Arguments) {
+			*args.Get(2).(*[]client.ScriptedRestResource) = []client.ScriptedRestResource{
+				{
+					BaseResult: client.BaseResult{ID: "1"}, Name: "listItems", Active: true, HTTPMethod: "GET", RelativePath: "/items",
+					ShortDescription: "List items", OperationScript: "list();", Produces: "application/json", ProducesCustomized: true,
+				},
+				{
+					BaseResult: client.BaseResult{ID: "2"}, Name: "createItem", Active: true, HTTPMethod: "POST", RelativePath: "/items",
+					OperationScript: "create();", Produces: "application/json", ProducesCustomized: true, Consumes: "application/json", ConsumesCustomized: true,
+				},
+			}
+		}).
+		Return(nil)
+	clientMock.On("UpdateObject", client.EndpointScriptedRestResource, mock.Anything).Return(nil)
+	clientMock.
+		On("GetObject", client.EndpointScriptedRestApi, "api", mock.Anything).
+		Run(func(args mock.Arguments) {
+			api := args.Get(2).(*client.ScriptedRestApi)
+			api.ID = "api"
+			api.Active = true
+			api.Scope = "global"
+		}).
+		Return(nil)
+	assert.NoError(t, res.Create(data, clientMock))
+
+	state := data.State()
+	diff, err := res.Diff(state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Empty())
+
+	state.Attributes["resources_checksum"] = "changed on the instance"
+	diff, err = res.Diff(state, terraform.NewResourceConfigRaw(config), nil)
+	assert.NoError(t, err)
+	assert.True(t, diff.Attributes["resources_checksum"].NewComputed)
+}
+
+func TestResourceScriptedRestAPIFromOpenAPIValidatesScripts(t *testing.T) {
+	res := resources.ResourceScriptedRestAPIFromOpenAPI()
+	base := map[string]interface{}{
+		"document": itemsOpenAPIDocument,
+	}
+
+	_, err := res.Diff(nil, resourceConfig(base, map[string]interface{}{
+		"scripts": map[string]interface{}{"listItems": "list();"},
+	}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{
+		"scripts": map[string]interface{}{"listItems": "list();", "createItem": "create();", "deleteItem": "delete();"},
+	}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{
+		"scripts": map[string]interface{}{"listItems": "list();", "createItem": "create();"},
+	}), nil)
+	assert.NoError(t, err)
+}
+
+func TestResourceScheduledJobValidatesRunType(t *testing.T) {
+	res := resources.ResourceScheduledJob()
+	base := map[string]interface{}{
+		"name":   "My Job",
+		"script": "gs.info('hello');",
+	}
+
+	_, err := res.Diff(nil, resourceConfig(base, map[string]interface{}{"run_type": "weekly", "time": "08:00:00"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"run_type": "weekly", "time": "08:00:00", "day_of_week": 2}), nil)
+	assert.NoError(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"run_type": "periodically"}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"run_type": "on_demand", "conditional": true}), nil)
+	assert.Error(t, err)
+
+	_, err = res.Diff(nil, resourceConfig(base, map[string]interface{}{"run_type": "on_demand"}), nil)
+	assert.NoError(t, err)
+}